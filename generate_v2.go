@@ -0,0 +1,194 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+// Generates template_v2.go from the v2 chaining wrappers. It must be run with
+// v2_template.go, and needs Go 1.18 or later because the v2 package is type
+// checked. See v2_generate.go.
+
+import (
+	"bytes"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+var v2PackageTemplate = template.Must(template.New("").
+	Funcs(template.FuncMap{
+		"level": func(level int) string { return v2LevelNames[level] },
+	}).
+	Parse("// Code generated by go generate; DO NOT EDIT.\n" +
+		"package main\n" +
+		"\n" +
+		"var pieV2Templates = map[string]v2Template{\n" +
+		"{{ range $fn, $t := . }}" +
+		"\t\"{{ $fn }}\": {\n" +
+		"\t\tLevel: {{ level $t.Level }},\n" +
+		"{{ with $t.Imports }}\t\tImports: []string{ {{- range $i, $imp := . }}{{ if $i }}, {{ end }}{{ printf \"%q\" $imp }}{{ end -}} },\n{{ end }}" +
+		"\t\tCode: `{{ $t.Code }}`,\n" +
+		"\t},\n" +
+		"{{ end }}" +
+		"}\n"))
+
+// v2WrapperFiles are the generated chaining wrappers of v2, from the least to
+// the most restrictive constraint. Their order matches the v2Level constants.
+var v2WrapperFiles = []string{
+	"v2/of.go",
+	"v2/of_comparable.go",
+	"v2/of_ordered.go",
+	"v2/of_numeric.go",
+}
+
+// generateV2Templates converts the methods of the v2 chaining wrappers into
+// templates for named slice types that call the v2 functions.
+func generateV2Templates() {
+	data := map[string]v2Template{}
+	pieTypes := v2PieTypes()
+
+	for level, fileName := range v2WrapperFiles {
+		file, err := ioutil.ReadFile(fileName)
+		if err != nil {
+			panic(err)
+		}
+
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, "", file, parser.ParseComments)
+		if err != nil {
+			panic(err)
+		}
+
+		imports := map[string]string{}
+		for _, imp := range f.Imports {
+			path := strings.Trim(imp.Path.Value, `"`)
+			imports[path[strings.LastIndex(path, "/")+1:]] = path
+		}
+
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil {
+				continue
+			}
+
+			// A method belongs to the least restrictive wrapper it appears in.
+			if _, ok := data[fn.Name.Name]; ok {
+				continue
+			}
+
+			code := string(file[fset.Position(fn.Pos()).Offset:fset.Position(fn.End()).Offset])
+
+			var methodImports []string
+			for name, path := range imports {
+				if name != "constraints" && strings.Contains(code, name+".") {
+					methodImports = append(methodImports, path)
+				}
+			}
+
+			doc := ""
+			if fn.Doc != nil {
+				doc = string(file[fset.Position(fn.Doc.Pos()).Offset:fset.Position(fn.Pos()).Offset])
+			}
+
+			data[fn.Name.Name] = v2Template{
+				Level:   level,
+				Imports: methodImports,
+				Code:    doc + convertV2Method(fn.Name.Name, code, pieTypes) + "\n",
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := v2PackageTemplate.Execute(&buf, data); err != nil {
+		panic(err)
+	}
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		panic(err)
+	}
+
+	err = ioutil.WriteFile("template_v2.go", source, 0644)
+	if err != nil {
+		panic(err)
+	}
+}
+
+var (
+	v2Receiver      = regexp.MustCompile(`^func \(o (\*?)Of\w*Slice\[T\]\)`)
+	v2ChainedReturn = regexp.MustCompile(`Of\w*Slice\[T\]\{(.*)\}\n`)
+	v2Wrapper       = regexp.MustCompile(`Of\w*Slice\[T\]`)
+	v2TypeParam     = regexp.MustCompile(`\bT\b`)
+)
+
+// v2PieTypes type checks the v2 package and returns a regexp that matches the
+// exported types declared in it. They must be qualified with "pie." when they
+// are used in the generated methods.
+func v2PieTypes() *regexp.Regexp {
+	dir, err := filepath.Abs("v2")
+	if err != nil {
+		panic(err)
+	}
+
+	// The imports must be found in the v2 module rather than this one.
+	build.Default.Dir = dir
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		panic(err)
+	}
+
+	var files []*ast.File
+	for _, file := range pkgs["pie"].Files {
+		files = append(files, file)
+	}
+
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	pkg, err := conf.Check("github.com/elliotchance/pie/v2", fset, files, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	var names []string
+	for _, name := range pkg.Scope().Names() {
+		if obj, ok := pkg.Scope().Lookup(name).(*types.TypeName); ok && obj.Exported() {
+			names = append(names, name)
+		}
+	}
+
+	// Selectors, such as the fields of a type, must not be qualified. Variadic
+	// parameters must be.
+	return regexp.MustCompile(`(^|\.\.\.|[^.\w])(` + strings.Join(names, "|") + `)\b`)
+}
+
+// convertV2Method turns a method on one of the v2 wrappers into a method on
+// SliceType that calls the v2 function directly.
+func convertV2Method(name, code string, pieTypes *regexp.Regexp) string {
+	code = v2Receiver.ReplaceAllString(code, "func (ss ${1}SliceType)")
+	code = v2ChainedReturn.ReplaceAllString(code, "$1\n")
+	code = v2Wrapper.ReplaceAllString(code, "SliceType")
+	code = v2TypeParam.ReplaceAllString(code, "ElementType")
+	code = pieTypes.ReplaceAllString(code, "${1}pie.$2")
+	code = strings.Replace(code, "&o.Result", "(*[]ElementType)(ss)", -1)
+	code = strings.Replace(code, "o.Result", "ss", -1)
+	code = strings.Replace(code, "\t"+name+"(", "\tpie."+name+"(", -1)
+	code = strings.Replace(code, "return "+name+"(", "return pie."+name+"(", -1)
+
+	return code
+}
+
+func main() {
+	generateV2Templates()
+}
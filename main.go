@@ -3,6 +3,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"go/ast"
 	"go/parser"
@@ -155,15 +156,32 @@ func isSelfPackage(packageName string) bool {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrate(os.Args[2:])
+		return
+	}
+
+	v2 := flag.Bool("v2", false, "generate methods that call the generic "+
+		"functions in github.com/elliotchance/pie/v2 (requires Go 1.18+)")
+	flag.Parse()
+
 	fset := token.NewFileSet()
 	pkgs, err := parser.ParseDir(fset, ".", nil, 0)
 	check(err)
 
-	for _, arg := range os.Args[1:] {
+	for _, arg := range flag.Args() {
 		mapOrSliceType, fns := getFunctionsFromArg(arg)
 		packageName, keyType, elementType, explorer := findType(pkgs, mapOrSliceType)
 		kind := getType(keyType, elementType)
 
+		if *v2 {
+			t := generateV2(packageName, mapOrSliceType, elementType, fns,
+				getV2Level(fset, pkgs[packageName], mapOrSliceType))
+			err := ioutil.WriteFile(strings.ToLower(mapOrSliceType)+"_pie.go", []byte(t), 0644)
+			check(err)
+			continue
+		}
+
 		var templates []string
 		for _, function := range functions.Functions {
 			if fns[0] != "*" && !stringSliceContains(fns, function.Name) {
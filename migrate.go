@@ -0,0 +1,716 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	v1ImportPath = "github.com/elliotchance/pie/pie"
+	v2ImportPath = "github.com/elliotchance/pie/v2"
+)
+
+// v1BuiltinTypes are the slice types provided by the v1 pie package and the
+// plain slice types that replace them.
+var v1BuiltinTypes = map[string]string{
+	"Strings":  "[]string",
+	"Ints":     "[]int",
+	"Float64s": "[]float64",
+}
+
+// v1Hints suggest what to use instead of the v1 functions that do not exist in
+// v2.
+var v1Hints = map[string]string{
+	"Abs":    ", use pie.Map with pie.Abs",
+	"Append": ", use the append builtin",
+	"Extend": ", use the append builtin",
+}
+
+// migration holds the state for migrating a module from the methods generated
+// by v1 to the v2 functions.
+type migration struct {
+	fset       *token.FileSet
+	dryRun     bool
+	report     []string
+	calls      int
+	changed    map[string]*ast.File
+	generated  map[string]struct{}
+	directives map[*ast.Comment]struct{}
+}
+
+func (m *migration) reportf(pos token.Pos, format string, args ...interface{}) {
+	m.report = append(m.report,
+		fmt.Sprintf("%s: %s", m.fset.Position(pos), fmt.Sprintf(format, args...)))
+}
+
+// runMigrate implements "pie migrate [-n] [dir]". It finds call sites of
+// methods generated by v1 (and the types in the v1 pie package) and rewrites
+// them to call the v2 functions. The generated files and their go:generate
+// directives are removed. Anything that could not be translated is reported.
+func runMigrate(args []string) {
+	flags := flag.NewFlagSet("migrate", flag.ExitOnError)
+	dryRun := flags.Bool("n", false, "print the report without changing any files")
+	check(flags.Parse(args))
+
+	root := "."
+	if flags.NArg() > 0 {
+		root = flags.Arg(0)
+	}
+
+	m := newMigration(*dryRun)
+	check(m.migrate(root))
+
+	for _, line := range m.report {
+		fmt.Println(line)
+	}
+
+	fmt.Printf("%d call sites translated, %d files changed, %d generated files removed, %d problems\n",
+		m.calls, len(m.changed), len(m.generated), len(m.report))
+
+	if len(m.report) > 0 {
+		os.Exit(1)
+	}
+}
+
+func newMigration(dryRun bool) *migration {
+	return &migration{
+		fset:       token.NewFileSet(),
+		dryRun:     dryRun,
+		changed:    map[string]*ast.File{},
+		generated:  map[string]struct{}{},
+		directives: map[*ast.Comment]struct{}{},
+	}
+}
+
+// migrate rewrites every package in root and its subdirectories, then writes
+// the changes unless it is a dry run.
+func (m *migration) migrate(root string) error {
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.IsDir() {
+			return nil
+		}
+
+		name := info.Name()
+		if path != root && (name == "vendor" || name == "testdata" ||
+			strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+			return filepath.SkipDir
+		}
+
+		m.migrateDir(path)
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return m.write()
+}
+
+func (m *migration) migrateDir(dir string) {
+	pkgs, err := parser.ParseDir(m.fset, dir, nil, parser.ParseComments)
+	if err != nil {
+		m.report = append(m.report, err.Error())
+		return
+	}
+
+	// The generated files must be found for all packages first, otherwise the
+	// external test package would not know which types they belong to.
+	for _, pkg := range pkgs {
+		m.findDirectives(dir, pkg)
+	}
+
+	var names []string
+	for name := range pkgs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		m.migratePackage(dir, pkgs[name])
+	}
+}
+
+// findDirectives records every "//go:generate pie" comment and the files that
+// it would have generated.
+func (m *migration) findDirectives(dir string, pkg *ast.Package) {
+	for _, file := range pkg.Files {
+		for _, group := range file.Comments {
+			for _, comment := range group.List {
+				fields := strings.Fields(strings.TrimPrefix(comment.Text, "//go:generate"))
+				if !strings.HasPrefix(comment.Text, "//go:generate") ||
+					len(fields) == 0 || fields[0] != "pie" {
+					continue
+				}
+
+				m.directives[comment] = struct{}{}
+
+				for _, arg := range fields[1:] {
+					if strings.HasPrefix(arg, "-") {
+						continue
+					}
+
+					mapOrSliceType, _ := getFunctionsFromArg(arg)
+					path := filepath.Join(dir, strings.ToLower(mapOrSliceType)+"_pie.go")
+					m.generated[path] = struct{}{}
+				}
+			}
+		}
+	}
+}
+
+func (m *migration) isGenerated(pos token.Pos) bool {
+	_, ok := m.generated[m.fset.Position(pos).Filename]
+
+	return ok
+}
+
+func (m *migration) migratePackage(dir string, pkg *ast.Package) {
+	var files []*ast.File
+	var fileNames []string
+	for fileName := range pkg.Files {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+
+	for _, fileName := range fileNames {
+		files = append(files, pkg.Files[fileName])
+	}
+
+	info := &types.Info{
+		Types:      map[ast.Expr]types.TypeAndValue{},
+		Uses:       map[*ast.Ident]types.Object{},
+		Selections: map[*ast.SelectorExpr]*types.Selection{},
+	}
+	conf := types.Config{
+		Importer: importer.ForCompiler(m.fset, "source", nil),
+		Error: func(err error) {
+			// Type errors are reported, but the rest of the package is still
+			// migrated as best as possible.
+			m.report = append(m.report, err.Error())
+		},
+	}
+	typesPkg, _ := conf.Check(dir, m.fset, files, info)
+
+	for _, fileName := range fileNames {
+		if _, ok := m.generated[fileName]; ok {
+			continue
+		}
+
+		file := pkg.Files[fileName]
+		if m.migrateFile(file, typesPkg, info) {
+			m.changed[fileName] = file
+		}
+	}
+}
+
+// migrateFile returns true if the file was modified.
+func (m *migration) migrateFile(file *ast.File, pkg *types.Package, info *types.Info) bool {
+	qualifier := types.RelativeTo(pkg)
+
+	// Call sites are collected before any are rewritten because the type
+	// information refers to the original expressions.
+	var calls []*ast.CallExpr
+	var sliceTypes []*ast.SelectorExpr
+	changed := false
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.CallExpr:
+			if sel, ok := n.Fun.(*ast.SelectorExpr); ok && m.isV1Method(sel, info) {
+				calls = append(calls, n)
+			}
+
+		case *ast.SelectorExpr:
+			if obj, ok := info.Uses[n.Sel].(*types.TypeName); ok &&
+				obj.Pkg() != nil && obj.Pkg().Path() == v1ImportPath {
+				sliceTypes = append(sliceTypes, n)
+			}
+		}
+
+		return true
+	})
+
+	// Method values (such as passing cars.Filter as a func) cannot be
+	// translated automatically.
+	called := map[*ast.SelectorExpr]struct{}{}
+	for _, call := range calls {
+		called[call.Fun.(*ast.SelectorExpr)] = struct{}{}
+	}
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		if sel, ok := node.(*ast.SelectorExpr); ok && m.isV1Method(sel, info) {
+			if _, ok := called[sel]; !ok {
+				m.reportf(sel.Pos(), "method value %s.%s cannot be translated",
+					exprString(m.fset, sel.X), sel.Sel.Name)
+			}
+		}
+
+		return true
+	})
+
+	for _, call := range calls {
+		if m.translateCall(call, info, qualifier) {
+			changed = true
+			m.calls++
+		}
+	}
+
+	for _, sel := range sliceTypes {
+		replacement := v1BuiltinTypes[sel.Sel.Name]
+		if replacement == "" {
+			m.reportf(sel.Pos(), "pie.%s has no replacement in v2", sel.Sel.Name)
+			continue
+		}
+
+		replaceExpr(file, sel, &ast.ArrayType{Elt: ast.NewIdent(replacement[2:])})
+		changed = true
+	}
+
+	if hasDirectives(file, m.directives) {
+		changed = true
+	}
+
+	if changed {
+		fixImports(file, info)
+	}
+
+	return changed
+}
+
+// isV1Method returns true if sel refers to a method that was generated by v1,
+// or is provided by one of the v1 types.
+func (m *migration) isV1Method(sel *ast.SelectorExpr, info *types.Info) bool {
+	selection, ok := info.Selections[sel]
+	if !ok || selection.Kind() == types.FieldVal {
+		return false
+	}
+
+	obj := selection.Obj()
+
+	return m.isGenerated(obj.Pos()) ||
+		(obj.Pkg() != nil && obj.Pkg().Path() == v1ImportPath)
+}
+
+// translateCall rewrites recv.Fn(args...) into pie.Fn(recv, args...) using the
+// v2 templates to find the position of each argument. It returns false and
+// reports the problem if the call cannot be translated.
+func (m *migration) translateCall(call *ast.CallExpr, info *types.Info, qualifier types.Qualifier) bool {
+	sel := call.Fun.(*ast.SelectorExpr)
+	name := sel.Sel.Name
+	recv := info.Types[sel.X].Type
+	pos := call.Pos()
+
+	// Methods with a pointer receiver can be called on a pointer or an
+	// addressable value.
+	recvType, isPointer := recv, false
+	if ptr, ok := recv.Underlying().(*types.Pointer); ok {
+		recvType, isPointer = ptr.Elem(), true
+	}
+
+	value := sel.X
+	if isPointer {
+		value = &ast.StarExpr{X: sel.X}
+	}
+
+	if name == "Len" {
+		call.Fun = ast.NewIdent("len")
+		call.Args = []ast.Expr{value}
+
+		return true
+	}
+
+	if _, ok := recvType.Underlying().(*types.Map); ok {
+		if name != "Keys" && name != "Values" {
+			m.reportf(pos, "%s is not available for maps in v2", name)
+			return false
+		}
+
+		call.Fun = &ast.SelectorExpr{X: ast.NewIdent("pie"), Sel: ast.NewIdent(name)}
+		call.Args = []ast.Expr{value}
+
+		return true
+	}
+
+	slice, ok := recvType.Underlying().(*types.Slice)
+	if !ok {
+		m.reportf(pos, "%s: receiver %s is not a slice", name, recv)
+		return false
+	}
+
+	tmpl, ok := pieV2Templates[name]
+	if !ok {
+		m.reportf(pos, "%s does not exist in v2%s", name, v1Hints[name])
+		return false
+	}
+
+	if level := getV2ElementLevel(slice.Elem()); tmpl.Level > level {
+		m.reportf(pos, "%s is not available in v2 for elements of type %s",
+			name, types.TypeString(slice.Elem(), qualifier))
+		return false
+	}
+
+	method, v2Call, err := parseV2Template(tmpl)
+	if err != nil {
+		m.reportf(pos, "%s: %v", name, err)
+		return false
+	}
+
+	// Such as Random, which also returns a bool in v2.
+	v1Results := 1
+	if tuple, ok := info.TypeOf(call).(*types.Tuple); ok {
+		v1Results = tuple.Len()
+	}
+
+	if v2Results := method.Type.Results.NumFields(); v2Results != v1Results {
+		m.reportf(pos, "%s returns %d values in v2", name, v2Results)
+		return false
+	}
+
+	// Each parameter of the template is matched to the argument in the same
+	// position. A variadic parameter receives all remaining arguments.
+	params := map[string][]ast.Expr{}
+	i := 0
+	variadic := false
+	for _, field := range method.Type.Params.List {
+		for _, paramName := range field.Names {
+			if _, ok := field.Type.(*ast.Ellipsis); ok {
+				params[paramName.Name] = call.Args[i:]
+				i, variadic = len(call.Args), true
+				break
+			}
+
+			if i >= len(call.Args) {
+				break
+			}
+
+			params[paramName.Name] = call.Args[i : i+1]
+			i++
+		}
+	}
+
+	if len(params) != countParams(method) || (!variadic && i != len(call.Args)) {
+		m.reportf(pos, "%s has different parameters in v2", name)
+		return false
+	}
+
+	receiver := value
+	if _, ok := method.Recv.List[0].Type.(*ast.StarExpr); ok {
+		pointer := sel.X
+		if !isPointer {
+			pointer = &ast.UnaryExpr{Op: token.AND, X: sel.X}
+		}
+
+		receiver = &ast.CallExpr{
+			Fun: &ast.ParenExpr{X: &ast.StarExpr{X: &ast.ArrayType{
+				Elt: ast.NewIdent(types.TypeString(slice.Elem(), qualifier)),
+			}}},
+			Args: []ast.Expr{pointer},
+		}
+	}
+
+	var args []ast.Expr
+	for _, arg := range v2Call.Args {
+		if ident, ok := arg.(*ast.Ident); ok && ident.Name != "ss" {
+			args = append(args, params[ident.Name]...)
+			continue
+		}
+
+		// Either ss, or the conversion of ss used by Pop.
+		args = append(args, receiver)
+	}
+
+	call.Fun = &ast.SelectorExpr{X: ast.NewIdent("pie"), Sel: ast.NewIdent(name)}
+	call.Args = args
+
+	if strings.Contains(pieTemplates[name], ".Equals(") && hasMethod(slice.Elem(), "Equals") {
+		m.reportf(pos, "%s was translated, but v2 compares elements with == instead of the Equals method", name)
+	}
+
+	return true
+}
+
+// getV2ElementLevel returns the most restrictive constraint that the element
+// type satisfies.
+func getV2ElementLevel(elem types.Type) int {
+	if basic, ok := elem.Underlying().(*types.Basic); ok {
+		switch {
+		case basic.Info()&(types.IsInteger|types.IsFloat) != 0:
+			return v2Numeric
+
+		case basic.Info()&types.IsString != 0:
+			return v2Ordered
+		}
+	}
+
+	if types.Comparable(elem) {
+		return v2Comparable
+	}
+
+	return v2Any
+}
+
+func hasMethod(typ types.Type, name string) bool {
+	obj, _, _ := types.LookupFieldOrMethod(typ, true, nil, name)
+	_, ok := obj.(*types.Func)
+
+	return ok
+}
+
+func countParams(method *ast.FuncDecl) (n int) {
+	for _, field := range method.Type.Params.List {
+		n += len(field.Names)
+	}
+
+	return
+}
+
+// parseV2Template returns the method declaration and the call to the v2
+// function within it.
+func parseV2Template(tmpl v2Template) (*ast.FuncDecl, *ast.CallExpr, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), "", "package p\n"+tmpl.Code, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	method := f.Decls[0].(*ast.FuncDecl)
+
+	var v2Call *ast.CallExpr
+	ast.Inspect(method.Body, func(node ast.Node) bool {
+		if call, ok := node.(*ast.CallExpr); ok && v2Call == nil {
+			if sel, ok := call.Fun.(*ast.SelectorExpr); ok && getIdentName(sel.X) == "pie" {
+				v2Call = call
+			}
+		}
+
+		return true
+	})
+
+	if v2Call == nil {
+		return nil, nil, fmt.Errorf("template does not call pie")
+	}
+
+	return method, v2Call, nil
+}
+
+// hasDirectives returns true if the file contains any "//go:generate pie"
+// comments.
+func hasDirectives(file *ast.File, directives map[*ast.Comment]struct{}) bool {
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			if _, ok := directives[comment]; ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// removeDirectives removes the "//go:generate pie" lines from the source. This
+// is done on the text rather than the AST so that the surrounding comments are
+// not moved. An empty "//" line left at the end of a doc comment is also
+// removed.
+func removeDirectives(source []byte, directives map[*ast.Comment]struct{}) []byte {
+	texts := map[string]struct{}{}
+	for comment := range directives {
+		texts[comment.Text] = struct{}{}
+	}
+
+	var lines []string
+	for _, line := range strings.Split(string(source), "\n") {
+		if _, ok := texts[strings.TrimSpace(line)]; ok {
+			if n := len(lines); n > 0 && strings.TrimSpace(lines[n-1]) == "//" {
+				lines = lines[:n-1]
+			}
+
+			continue
+		}
+
+		lines = append(lines, line)
+	}
+
+	return []byte(strings.Join(lines, "\n"))
+}
+
+// fixImports replaces the v1 import with v2, or adds the v2 import if any of
+// the v2 functions are used.
+func fixImports(file *ast.File, info *types.Info) {
+	usesPie := false
+	ast.Inspect(file, func(node ast.Node) bool {
+		if sel, ok := node.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok && ident.Name == "pie" {
+				// Calls that have been translated have no type information.
+				// Otherwise it must still refer to the v1 package.
+				pkgName, ok := info.Uses[ident].(*types.PkgName)
+				if info.Uses[ident] == nil || (ok && pkgName.Imported().Path() == v1ImportPath) {
+					usesPie = true
+				}
+			}
+		}
+
+		return true
+	})
+
+	for _, imp := range file.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		if path == v1ImportPath {
+			if usesPie {
+				imp.Path.Value = strconv.Quote(v2ImportPath)
+			} else {
+				removeImport(file, imp)
+			}
+
+			return
+		}
+	}
+
+	if usesPie {
+		addImport(file, v2ImportPath)
+	}
+}
+
+func removeImport(file *ast.File, imp *ast.ImportSpec) {
+	for i, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.IMPORT {
+			continue
+		}
+
+		for j, spec := range genDecl.Specs {
+			if spec == imp {
+				genDecl.Specs = append(genDecl.Specs[:j], genDecl.Specs[j+1:]...)
+				switch len(genDecl.Specs) {
+				case 0:
+					file.Decls = append(file.Decls[:i], file.Decls[i+1:]...)
+
+				case 1:
+					// A single import does not need the parentheses.
+					genDecl.Lparen = token.NoPos
+				}
+
+				return
+			}
+		}
+	}
+}
+
+func addImport(file *ast.File, path string) {
+	spec := &ast.ImportSpec{Path: &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(path)}}
+
+	for _, decl := range file.Decls {
+		if genDecl, ok := decl.(*ast.GenDecl); ok && genDecl.Tok == token.IMPORT {
+			if !genDecl.Lparen.IsValid() {
+				genDecl.Lparen = genDecl.Pos()
+				genDecl.Rparen = genDecl.End()
+			}
+
+			genDecl.Specs = append(genDecl.Specs, spec)
+
+			return
+		}
+	}
+
+	file.Decls = append([]ast.Decl{&ast.GenDecl{Tok: token.IMPORT, Specs: []ast.Spec{spec}}}, file.Decls...)
+}
+
+// write saves all of the changed files and removes the generated files.
+func (m *migration) write() error {
+	var fileNames []string
+	for fileName := range m.changed {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+
+	for _, fileName := range fileNames {
+		var buf bytes.Buffer
+		if err := printer.Fprint(&buf, m.fset, m.changed[fileName]); err != nil {
+			return err
+		}
+
+		// Formatting again sorts the imports that may have been added.
+		source, err := format.Source(removeDirectives(buf.Bytes(), m.directives))
+		if err != nil {
+			return err
+		}
+
+		fmt.Println("rewrote", fileName)
+		if !m.dryRun {
+			if err := ioutil.WriteFile(fileName, source, 0644); err != nil {
+				return err
+			}
+		}
+	}
+
+	for fileName := range m.generated {
+		if _, err := os.Stat(fileName); err != nil {
+			continue
+		}
+
+		fmt.Println("removed", fileName)
+		if !m.dryRun {
+			if err := os.Remove(fileName); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// replaceExpr replaces every reference to old within root with new.
+func replaceExpr(root ast.Node, old, new ast.Expr) {
+	exprType := reflect.TypeOf((*ast.Expr)(nil)).Elem()
+
+	ast.Inspect(root, func(node ast.Node) bool {
+		v := reflect.ValueOf(node)
+		if node == nil || v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+			return true
+		}
+
+		v = v.Elem()
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Field(i)
+
+			switch {
+			case field.Type() == exprType && field.Interface() == old:
+				field.Set(reflect.ValueOf(new))
+
+			case field.Kind() == reflect.Slice && field.Type().Elem() == exprType:
+				for j := 0; j < field.Len(); j++ {
+					if field.Index(j).Interface() == old {
+						field.Index(j).Set(reflect.ValueOf(new))
+					}
+				}
+			}
+		}
+
+		return true
+	})
+}
+
+func exprString(fset *token.FileSet, expr ast.Expr) string {
+	var buf bytes.Buffer
+	_ = format.Node(&buf, fset, expr)
+
+	return buf.String()
+}
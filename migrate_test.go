@@ -0,0 +1,73 @@
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/elliotchance/testify-stats/assert"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// TestMigrate migrates a copy of each input package in testdata/migrate and
+// compares the files that remain, and the problems that were reported, to the
+// golden files. The v1 pie package imported by the inputs is found in this
+// module. Run with -update to regenerate the golden files.
+func TestMigrate(t *testing.T) {
+	cases, err := ioutil.ReadDir("testdata/migrate")
+	assert.NoError(t, err)
+
+	for _, c := range cases {
+		t.Run(c.Name(), func(t *testing.T) {
+			golden := filepath.Join("testdata/migrate", c.Name())
+			dir := t.TempDir()
+			copyGoFiles(t, filepath.Join(golden, "input"), dir)
+
+			m := newMigration(false)
+			assert.NoError(t, m.migrate(dir))
+
+			report := strings.Join(m.report, "\n")
+			report = strings.Replace(report, dir+string(filepath.Separator), "", -1)
+
+			if *update {
+				assert.NoError(t, os.RemoveAll(filepath.Join(golden, "output")))
+				copyGoFiles(t, dir, filepath.Join(golden, "output"))
+				assert.NoError(t, ioutil.WriteFile(filepath.Join(golden, "report.txt"), []byte(report), 0644))
+			}
+
+			expectedReport, err := ioutil.ReadFile(filepath.Join(golden, "report.txt"))
+			assert.NoError(t, err)
+			assert.Equal(t, string(expectedReport), report)
+
+			assert.Equal(t, readGoFiles(t, filepath.Join(golden, "output")), readGoFiles(t, dir))
+		})
+	}
+}
+
+func readGoFiles(t *testing.T, dir string) map[string]string {
+	files, err := ioutil.ReadDir(dir)
+	assert.NoError(t, err)
+
+	sources := map[string]string{}
+	for _, file := range files {
+		if strings.HasSuffix(file.Name(), ".go") {
+			source, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+			assert.NoError(t, err)
+			sources[file.Name()] = string(source)
+		}
+	}
+
+	return sources
+}
+
+func copyGoFiles(t *testing.T, from, to string) {
+	assert.NoError(t, os.MkdirAll(to, 0755))
+
+	for name, source := range readGoFiles(t, from) {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(to, name), []byte(source), 0644))
+	}
+}
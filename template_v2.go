@@ -0,0 +1,1823 @@
+// Code generated by go generate; DO NOT EDIT.
+package main
+
+var pieV2Templates = map[string]v2Template{
+	"Add": {
+		Level: v2Numeric,
+		Code: `// Add returns the sum of the elements at each position of ss1 and ss2:
+//
+//	Add([1, 2, 3], [10, 20, 30]) => [11, 22, 33]
+//
+// ErrUnequalLengths is returned if ss1 and ss2 are not the same length. nil is
+// returned if there are no elements.
+func (ss SliceType) Add(ss2 []ElementType) ([]ElementType, error) {
+	return pie.Add(ss, ss2)
+}
+`,
+	},
+	"All": {
+		Level: v2Any,
+		Code: `// All will return true if all callbacks return true. It follows the same logic
+// as the all() function in Python.
+//
+// If the list is empty then true is always returned.
+func (ss SliceType) All(fn func(value ElementType) bool) bool {
+	return pie.All(ss, fn)
+}
+`,
+	},
+	"Any": {
+		Level: v2Any,
+		Code: `// Any will return true if any callbacks return true. It follows the same logic
+// as the any() function in Python.
+//
+// If the list is empty then false is always returned.
+func (ss SliceType) Any(fn func(value ElementType) bool) bool {
+	return pie.Any(ss, fn)
+}
+`,
+	},
+	"AreSorted": {
+		Level: v2Ordered,
+		Code: `// AreSorted will return true if the slice is already sorted. It is a wrapper
+// for sort.SliceIsSorted.
+func (ss SliceType) AreSorted() bool {
+	return pie.AreSorted(ss)
+}
+`,
+	},
+	"AreUnique": {
+		Level: v2Comparable,
+		Code: `// AreUnique will return true if the slice contains elements that are all
+// different (unique) from each other.
+func (ss SliceType) AreUnique() bool {
+	return pie.AreUnique(ss)
+}
+`,
+	},
+	"Average": {
+		Level: v2Numeric,
+		Code: `// Average is the average of all of the elements, or zero if there are no
+// elements.
+func (ss SliceType) Average() float64 {
+	return pie.Average(ss)
+}
+`,
+	},
+	"Bottom": {
+		Level: v2Any,
+		Code: `// Bottom will return n elements from bottom
+//
+// that means that elements is taken from the end of the slice
+// for this [1,2,3] slice with n == 2 will be returned [3,2]
+// if the slice has less elements then n that'll return all elements
+// if n < 0 it'll return empty slice.
+func (ss SliceType) Bottom(n int) SliceType {
+	return pie.Bottom(ss, n)
+}
+`,
+	},
+	"Bucketize": {
+		Level: v2Numeric,
+		Code: `// Bucketize returns the index of the bucket for each element, where bucket i
+// is between edges[i] and edges[i+1]. Each bucket includes the lower edge but
+// not the upper edge, except for the last bucket, which includes both:
+//
+//	Bucketize([0, 4.5, 5, 10, 11], [0, 5, 10]) => [0, 0, 1, 1, -1]
+//
+// -1 is used for elements outside of the edges and NaN. It will panic if there
+// are fewer than two edges or the edges are not sorted.
+func (ss SliceType) Bucketize(edges []float64) []int {
+	return pie.Bucketize(ss, edges)
+}
+`,
+	},
+	"Chunk": {
+		Level: v2Any,
+		Code: `// Chunk splits the input and returns multi slices whose length equals chunkLength,
+// except for the last slice which may contain fewer elements. The chunks are
+// copied from the input, so appending to one chunk will not change the next.
+//
+// Examples:
+//
+//	Chunk([1, 2, 3], 4) => [ [1, 2, 3] ]
+//	Chunk([1, 2, 3], 3) => [ [1, 2, 3] ]
+//	Chunk([1, 2, 3], 2) => [ [1, 2], [3] ]
+//	Chunk([1, 2, 3], 1) => [ [1], [2], [3] ]
+//	Chunk([], 1)        => [ [] ]
+//	Chunk([1, 2, 3], 0) => panic: chunkLength should be greater than 0
+func (ss SliceType) Chunk(chunkLength int) [][]ElementType {
+	return pie.Chunk(ss, chunkLength)
+}
+`,
+	},
+	"Clamp": {
+		Level: v2Numeric,
+		Code: `// Clamp returns a new slice where elements less than lo are replaced with lo
+// and elements greater than hi are replaced with hi:
+//
+//	Clamp([-5, 2, 12], 0, 10) => [0, 2, 10]
+//
+// nil is returned if there are no elements in the slice. It will panic if lo is
+// greater than hi.
+func (ss SliceType) Clamp(lo, hi ElementType) SliceType {
+	return pie.Clamp(ss, lo, hi)
+}
+`,
+	},
+	"Combinations": {
+		Level: v2Any,
+		Code: `// Combinations returns every way to choose k elements from ss, where the order
+// does not matter. See CombinationsSeq for the order.
+//
+// ErrTooManyResults is returned (without generating any combinations) if there
+// would be more than max.
+func (ss SliceType) Combinations(k int, max int) ([][]ElementType, error) {
+	return pie.Combinations(ss, k, max)
+}
+`,
+	},
+	"CombinationsSeq": {
+		Level: v2Any,
+		Code: `// CombinationsSeq returns a sequence (that can be used as an iter.Seq) of
+// every way to choose k elements from ss, where the order does not matter.
+// Elements are treated as different based on their position, not their value.
+//
+// The chosen elements keep their order from ss, and the combinations are
+// produced in lexicographic order of the positions:
+//
+//	CombinationsSeq([a, b, c], 2) => [a, b], [a, c], [b, c]
+//
+// Nothing is produced if k is negative or greater than the length of ss. They
+// are generated lazily and each one is a new slice.
+func (ss SliceType) CombinationsSeq(k int) func(yield func([]ElementType) bool) {
+	return pie.CombinationsSeq(ss, k)
+}
+`,
+	},
+	"CombinationsWithReplacement": {
+		Level: v2Any,
+		Code: `// CombinationsWithReplacement returns every way to choose k elements from ss,
+// where the order does not matter and each element can be chosen more than
+// once. See CombinationsWithReplacementSeq for the order.
+//
+// ErrTooManyResults is returned (without generating any combinations) if there
+// would be more than max.
+func (ss SliceType) CombinationsWithReplacement(k int, max int) ([][]ElementType, error) {
+	return pie.CombinationsWithReplacement(ss, k, max)
+}
+`,
+	},
+	"CombinationsWithReplacementSeq": {
+		Level: v2Any,
+		Code: `// CombinationsWithReplacementSeq returns a sequence (that can be used as an
+// iter.Seq) of every way to choose k elements from ss, where the order does
+// not matter and each element can be chosen more than once:
+//
+//	CombinationsWithReplacementSeq([a, b, c], 2) => [a, a], [a, b], [a, c], [b, b], [b, c], [c, c]
+//
+// Nothing is produced if k is negative, or if ss is empty and k is greater
+// than zero. They are generated lazily and each one is a new slice.
+func (ss SliceType) CombinationsWithReplacementSeq(k int) func(yield func([]ElementType) bool) {
+	return pie.CombinationsWithReplacementSeq(ss, k)
+}
+`,
+	},
+	"Compact": {
+		Level: v2Comparable,
+		Code: `// Compact returns a new slice with all of the zero values (such as 0, "" or
+// nil) removed. The order of the remaining elements is retained.
+//
+// The returned slice may contain zero elements (nil).
+//
+// Compact is not the same as slices.Compact from the standard library, which
+// removes consecutive duplicates.
+func (ss SliceType) Compact() SliceType {
+	return pie.Compact(ss)
+}
+`,
+	},
+	"Contains": {
+		Level: v2Comparable,
+		Code: `// Contains returns true if the element exists in the slice.
+//
+// When using slices of pointers it will only compare by address, not value.
+func (ss SliceType) Contains(lookingFor ElementType) bool {
+	return pie.Contains(ss, lookingFor)
+}
+`,
+	},
+	"Covariance": {
+		Level: v2Numeric,
+		Code: `// Covariance is the population covariance of xs and ys, which is positive when
+// they tend to increase together and negative when one tends to decrease as the
+// other increases. Like Stddev, it divides by the number of elements.
+//
+// ErrUnequalLengths is returned if xs and ys are not the same length. Zero is
+// returned if there are no elements.
+func (ss SliceType) Covariance(ys []ElementType) (float64, error) {
+	return pie.Covariance(ss, ys)
+}
+`,
+	},
+	"CumulativeDot": {
+		Level: v2Numeric,
+		Code: `// CumulativeDot returns the running dot product of ss1 and ss2. Each value is
+// the Dot of the elements up to and including that position, in the same way
+// as CumulativeSum:
+//
+//	CumulativeDot([1, 2, 3], [4, 5, 6]) => [4, 14, 32]
+//
+// ErrUnequalLengths is returned if ss1 and ss2 are not the same length. nil is
+// returned if there are no elements.
+func (ss SliceType) CumulativeDot(ss2 []ElementType) ([]ElementType, error) {
+	return pie.CumulativeDot(ss, ss2)
+}
+`,
+	},
+	"CumulativeMax": {
+		Level: v2Ordered,
+		Code: `// CumulativeMax returns the running maximum of the elements. Each value is the
+// largest of the element at the same position and all of the elements before
+// it.
+//
+// nil is returned if there are no elements in the slice.
+//
+// Examples:
+//
+//	CumulativeMax([3, 1, 4, 1, 5]) => [3, 3, 4, 4, 5]
+func (ss SliceType) CumulativeMax() SliceType {
+	return pie.CumulativeMax(ss)
+}
+`,
+	},
+	"CumulativeProduct": {
+		Level: v2Numeric,
+		Code: `// CumulativeProduct returns the running product of the elements. Each value is
+// the product of the element at the same position and all of the elements
+// before it.
+//
+// nil is returned if there are no elements in the slice.
+//
+// Examples:
+//
+//	CumulativeProduct([1, 2, 3, 4]) => [1, 2, 6, 24]
+func (ss SliceType) CumulativeProduct() SliceType {
+	return pie.CumulativeProduct(ss)
+}
+`,
+	},
+	"CumulativeSum": {
+		Level: v2Numeric,
+		Code: `// CumulativeSum returns the running total of the elements. Each value is the
+// sum of the element at the same position and all of the elements before it.
+//
+// nil is returned if there are no elements in the slice.
+//
+// Examples:
+//
+//	CumulativeSum([1, 2, 3, 4]) => [1, 3, 6, 10]
+func (ss SliceType) CumulativeSum() SliceType {
+	return pie.CumulativeSum(ss)
+}
+`,
+	},
+	"Dedup": {
+		Level: v2Comparable,
+		Code: `// Dedup returns a new slice where each run of consecutive equal elements is
+// replaced with the first element of the run. Unlike Unique, elements that are
+// equal but not next to each other are kept, and the order is unchanged:
+//
+//	Dedup([a, a, b, b, b, a, c]) => [a, b, a, c]
+//
+// Sort the slice first to remove all duplicates. See inplace.Dedup to modify
+// the slice instead of allocating a new one.
+func (ss SliceType) Dedup() SliceType {
+	return pie.Dedup(ss)
+}
+`,
+	},
+	"Delete": {
+		Level: v2Any,
+		Code: `// Removes elements at indices in idx from input slice, returns resulting slice.
+// If an index is out of bounds, skip it.
+func (ss SliceType) Delete(idx ...int) SliceType {
+	return pie.Delete(ss, idx...)
+}
+`,
+	},
+	"Diff": {
+		Level: v2Comparable,
+		Code: `// Diff returns the elements that needs to be added or removed from the first
+// slice to have the same elements in the second slice.
+//
+// The order of elements is not taken into consideration, so the slices are
+// treated sets that allow duplicate items.
+//
+// The added and removed returned may be blank respectively, or contain upto as
+// many elements that exists in the largest slice.
+func (ss SliceType) Diff(against []ElementType) ([]ElementType, []ElementType) {
+	return pie.Diff(ss, against)
+}
+`,
+	},
+	"Diffs": {
+		Level: v2Numeric,
+		Code: `// Diffs returns the differences between adjacent elements, that is
+// ss[i+1]-ss[i] for each position. The result has one less element than the
+// input.
+//
+// nil is returned if there are less than two elements in the slice.
+//
+// Diffs is the inverse of CumulativeSum, apart from the first element.
+//
+// Examples:
+//
+//	Diffs([1, 3, 6, 10]) => [2, 3, 4]
+//	Diffs([5, 2])        => [-3]
+//	Diffs([5])           => []
+func (ss SliceType) Diffs() SliceType {
+	return pie.Diffs(ss)
+}
+`,
+	},
+	"Divide": {
+		Level: v2Numeric,
+		Code: `// Divide returns the elements of ss1 divided by the element at the same position
+// of ss2:
+//
+//	Divide([10, 20, 30], [2, 4, 5]) => [5, 5, 6]
+//
+// ErrUnequalLengths is returned if ss1 and ss2 are not the same length. nil is
+// returned if there are no elements.
+//
+// Like the / operator, integers are truncated and dividing an integer by zero
+// will panic. Dividing a float by zero gives an infinity (or NaN for 0/0).
+func (ss SliceType) Divide(ss2 []ElementType) ([]ElementType, error) {
+	return pie.Divide(ss, ss2)
+}
+`,
+	},
+	"Dot": {
+		Level: v2Numeric,
+		Code: `// Dot returns the dot product of ss1 and ss2, which is the sum of the products
+// of the elements at each position:
+//
+//	Dot([1, 2, 3], [4, 5, 6]) => 32
+//
+// ErrUnequalLengths is returned if ss1 and ss2 are not the same length. Zero is
+// returned if there are no elements.
+//
+// The products are added to four separate totals that are combined at the end.
+// This is about twice as fast as a single total (see BenchmarkVectorDot), but
+// for floats the result may be slightly different from adding them in order.
+func (ss SliceType) Dot(ss2 []ElementType) (ElementType, error) {
+	return pie.Dot(ss, ss2)
+}
+`,
+	},
+	"DropTop": {
+		Level: v2Any,
+		Code: `// DropTop will return the rest slice after dropping the top n elements
+// if the slice has less elements then n that'll return empty slice
+// if n < 0 it'll return empty slice.
+func (ss SliceType) DropTop(n int) SliceType {
+	return pie.DropTop(ss, n)
+}
+`,
+	},
+	"DropWhile": {
+		Level: v2Comparable,
+		Code: `// Drop items from the slice while f(item) is true.
+// Afterwards, return every element until the slice is empty. It follows the
+// same logic as the dropwhile() function from itertools in Python.
+func (ss SliceType) DropWhile(f func(s ElementType) bool) SliceType {
+	return pie.DropWhile(ss, f)
+}
+`,
+	},
+	"Each": {
+		Level: v2Any,
+		Code: `// Each is more condensed version of Transform that allows an action to happen
+// on each elements and pass a copy of the slice on.
+//
+//	pie.Each(cars, func (car *Car) {
+//	    fmt.Printf("Car color is: %s\n", car.Color)
+//	})
+//
+// Pie will not ensure immutability on items passed in so they can be
+// manipulated, if you choose to do it this way, for example:
+//
+//	// Set all car colors to Red.
+//	pie.Each(cars, func (car *Car) {
+//	    car.Color = "Red"
+//	})
+func (ss SliceType) Each(fn func(ElementType)) SliceType {
+	return pie.Each(ss, fn)
+}
+`,
+	},
+	"Enumerate": {
+		Level: v2Any,
+		Code: `// Enumerate returns a new slice of pairs, where A is the index and B is the
+// element of ss at that index:
+//
+//	Enumerate([a, b, c]) => [{0, a}, {1, b}, {2, c}]
+func (ss SliceType) Enumerate() []pie.Zipped[int, ElementType] {
+	return pie.Enumerate(ss)
+}
+`,
+	},
+	"Equals": {
+		Level: v2Comparable,
+		Code: `// Equals compare elements from the start to the end,
+//
+// if they are the same is considered the slices are equal if all elements are
+// the same is considered the slices are equal
+// if each slice == nil is considered that they're equal
+//
+// if element realizes Equals interface it uses that method, in other way uses
+// default compare
+func (ss SliceType) Equals(rhs []ElementType) bool {
+	return pie.Equals(ss, rhs)
+}
+`,
+	},
+	"ExponentialMovingAverage": {
+		Level: v2Numeric,
+		Code: `// ExponentialMovingAverage returns the exponential moving average of the
+// elements, using alpha as the smoothing factor.
+//
+// The first value is the first element and each subsequent value is:
+//
+//	alpha*ss[i] + (1-alpha)*previous
+//
+// A larger alpha discounts older elements faster. An alpha of 1 returns the
+// elements unchanged. It will panic if alpha is not in the range (0, 1].
+//
+// nil is returned if there are no elements in the slice.
+func (ss SliceType) ExponentialMovingAverage(alpha float64) []float64 {
+	return pie.ExponentialMovingAverage(ss, alpha)
+}
+`,
+	},
+	"Filter": {
+		Level: v2Any,
+		Code: `// Filter will return a new slice containing only the elements that return
+// true from the condition. The returned slice may contain zero elements (nil).
+//
+// FilterNot works in the opposite way of Filter.
+func (ss SliceType) Filter(condition func(ElementType) bool) SliceType {
+	return pie.Filter(ss, condition)
+}
+`,
+	},
+	"FilterMap": {
+		Level: v2Any,
+		Code: `// FilterMap maps and filters in a single pass. The function returns the mapped
+// value and whether it should be included in the result.
+//
+// The returned slice may contain zero elements (nil).
+//
+// For example, parsing only the valid numbers:
+//
+//	pie.FilterMap([]string{"1", "x", "3"}, func(s string) (int, bool) {
+//	    i, err := strconv.Atoi(s)
+//	    return i, err == nil
+//	}) // [1 3]
+func (ss SliceType) FilterMap(fn func(ElementType) (ElementType, bool)) SliceType {
+	return pie.FilterMap(ss, fn)
+}
+`,
+	},
+	"FilterNot": {
+		Level: v2Any,
+		Code: `// FilterNot works the same as Filter, with a negated condition. That is, it will
+// return a new slice only containing the elements that returned false from the
+// condition. The returned slice may contain zero elements (nil).
+func (ss SliceType) FilterNot(condition func(ElementType) bool) SliceType {
+	return pie.FilterNot(ss, condition)
+}
+`,
+	},
+	"FindFirstUsing": {
+		Level: v2Any,
+		Code: `// FindFirstUsing will return the index of the first element when the callback
+// returns true or -1 if no element is found.
+// It follows the same logic as the findIndex() function in Javascript.
+//
+// If the list is empty then -1 is always returned.
+func (ss SliceType) FindFirstUsing(fn func(value ElementType) bool) int {
+	return pie.FindFirstUsing(ss, fn)
+}
+`,
+	},
+	"First": {
+		Level: v2Any,
+		Code: `// First returns the first element or a zero value if there are no elements.
+func (ss SliceType) First() ElementType {
+	return pie.First(ss)
+}
+`,
+	},
+	"FirstOr": {
+		Level: v2Any,
+		Code: `// FirstOr returns the first element or a default value if there are no
+// elements.
+func (ss SliceType) FirstOr(defaultValue ElementType) ElementType {
+	return pie.FirstOr(ss, defaultValue)
+}
+`,
+	},
+	"FlatMap": {
+		Level: v2Any,
+		Code: `// FlatMap maps each element to a slice and concatenates the results into a
+// single slice. It is equivalent to Flat(Map(ss, fn)) without the intermediate
+// two-dimensional slice.
+//
+// The returned slice may contain zero elements (nil).
+//
+// Examples:
+//
+//	FlatMap(["a b", "c"], strings.Fields) => ["a", "b", "c"]
+func (ss SliceType) FlatMap(fn func(ElementType) []ElementType) SliceType {
+	return pie.FlatMap(ss, fn)
+}
+`,
+	},
+	"Float64s": {
+		Level: v2Ordered,
+		Code: `// Float64s transforms each element to a float64.
+func (ss SliceType) Float64s() []float64 {
+	return pie.Float64s(ss)
+}
+`,
+	},
+	"Fold": {
+		Level: v2Any,
+		Code: `// Fold applies fn to each element from left to right, threading an
+// accumulator that starts as initial. Unlike Reduce, the accumulator may be a
+// different type to the elements.
+//
+// initial is returned if there are no elements in the slice.
+//
+// For example, summarising orders without converting them first:
+//
+//	total := pie.Fold(orders, 0.0, func(total float64, o Order) float64 {
+//	    return total + o.Amount
+//	})
+func (ss SliceType) Fold(initial ElementType, fn func(ElementType, ElementType) ElementType) ElementType {
+	return pie.Fold(ss, initial, fn)
+}
+`,
+	},
+	"FoldRight": {
+		Level: v2Any,
+		Code: `// FoldRight is the same as Fold except the elements are visited from right to
+// left, starting at the last element.
+//
+// initial is returned if there are no elements in the slice.
+func (ss SliceType) FoldRight(initial ElementType, fn func(ElementType, ElementType) ElementType) ElementType {
+	return pie.FoldRight(ss, initial, fn)
+}
+`,
+	},
+	"GeometricMean": {
+		Level: v2Numeric,
+		Code: `// GeometricMean is the nth root of the product of the n elements. It is
+// suited to values that are multiplied together, such as growth rates.
+//
+// It is calculated from the logarithms of the elements so that the product
+// cannot overflow. Zero is returned if there are no elements or any element is
+// zero. NaN is returned if any element is negative.
+func (ss SliceType) GeometricMean() float64 {
+	return pie.GeometricMean(ss)
+}
+`,
+	},
+	"Group": {
+		Level: v2Comparable,
+		Code: `// Group returns a map of the value with an individual count.
+func (ss SliceType) Group() map[ElementType]int {
+	return pie.Group(ss)
+}
+`,
+	},
+	"HarmonicMean": {
+		Level: v2Numeric,
+		Code: `// HarmonicMean is the number of elements divided by the sum of the reciprocals
+// of the elements. It is suited to rates, such as the average speed over equal
+// distances.
+//
+// Zero is returned if there are no elements or any element is zero. NaN is
+// returned if any element is negative.
+func (ss SliceType) HarmonicMean() float64 {
+	return pie.HarmonicMean(ss)
+}
+`,
+	},
+	"Histogram": {
+		Level: v2Numeric,
+		Code: `// Histogram counts the elements in bins of equal width between the smallest
+// and largest element. NaN and infinite values are not counted.
+//
+// If all of the elements are the same value, the bins cover the range of 0.5
+// either side of the value. nil is returned if there are no (finite) elements.
+// It will panic if bins is less than 1.
+//
+// See HistogramEdges and HistogramQuantiles for other ways to choose the bins.
+func (ss SliceType) Histogram(bins int) []pie.HistogramBin {
+	return pie.Histogram(ss, bins)
+}
+`,
+	},
+	"HistogramEdges": {
+		Level: v2Numeric,
+		Code: `// HistogramEdges counts the elements in the bins between each of the edges,
+// so there is one less bin than edges:
+//
+//	HistogramEdges([1, 2, 5, 10, 11], [0, 5, 10]) => [{0, 5, 2}, {5, 10, 2}]
+//
+// Elements outside of the edges (and NaN) are not counted. See Bucketize for
+// how the elements are assigned to bins. It will panic if there are fewer than
+// two edges or the edges are not sorted.
+func (ss SliceType) HistogramEdges(edges []float64) []pie.HistogramBin {
+	return pie.HistogramEdges(ss, edges)
+}
+`,
+	},
+	"HistogramQuantiles": {
+		Level: v2Numeric,
+		Code: `// HistogramQuantiles counts the elements in bins that each contain about the
+// same number of elements. The edges are the quantiles of the elements (using
+// linear interpolation), so the bins are narrower where the elements are
+// closer together.
+//
+// Repeated values can result in bins with a width of zero, which will be empty
+// unless it is the last bin. NaN and infinite values are not counted. nil is
+// returned if there are no (finite) elements. It will panic if bins is less
+// than 1.
+func (ss SliceType) HistogramQuantiles(bins int) []pie.HistogramBin {
+	return pie.HistogramQuantiles(ss, bins)
+}
+`,
+	},
+	"Insert": {
+		Level: v2Any,
+		Code: `// Insert a value at an index. If the index is greater than or equal to the
+// length of the slice, the values are appended to the end.
+func (ss SliceType) Insert(index int, values ...ElementType) SliceType {
+	return pie.Insert(ss, index, values...)
+}
+`,
+	},
+	"Intersect": {
+		Level: v2Comparable,
+		Code: `// Intersect returns items that exist in all lists.
+//
+// It returns slice without any duplicates.
+// If zero slice arguments are provided, then nil is returned.
+func (ss SliceType) Intersect(slices ...[]ElementType) SliceType {
+	return pie.Intersect(ss, slices...)
+}
+`,
+	},
+	"Ints": {
+		Level: v2Ordered,
+		Code: `// Ints transforms each element to an integer.
+func (ss SliceType) Ints() []int {
+	return pie.Ints(ss)
+}
+`,
+	},
+	"JSONBytes": {
+		Level: v2Ordered,
+		Code: `// JSONBytes returns the JSON encoded array as bytes.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+//
+// The error from the encoder is ignored (nil is returned for a NaN or infinite
+// float). Use JSONMarshal to encode other element types or to check for errors.
+func (ss SliceType) JSONBytes() []byte {
+	return pie.JSONBytes(ss)
+}
+`,
+	},
+	"JSONBytesIndent": {
+		Level: v2Ordered,
+		Code: `// JSONBytesIndent returns the JSON encoded array as bytes with indent applied.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array. See
+// json.MarshalIndent for details.
+//
+// The error from the encoder is ignored (nil is returned for a NaN or infinite
+// float). Use JSONMarshalIndent to encode other element types or to check for
+// errors.
+func (ss SliceType) JSONBytesIndent(prefix, indent string) []byte {
+	return pie.JSONBytesIndent(ss, prefix, indent)
+}
+`,
+	},
+	"JSONMarshal": {
+		Level: v2Any,
+		Code: `// JSONMarshal returns the JSON encoded array. Unlike JSONBytes, it works with
+// any element type (such as structs and pointers) and returns the error from
+// the encoder, for example for a NaN float or a failing json.Marshaler.
+//
+// Like JSONBytes, a nil slice is encoded as an empty array rather than null.
+func (ss SliceType) JSONMarshal() ([]byte, error) {
+	return pie.JSONMarshal(ss)
+}
+`,
+	},
+	"JSONMarshalIndent": {
+		Level: v2Any,
+		Code: `// JSONMarshalIndent works like JSONMarshal, but applies prefix and indent in
+// the same way as json.MarshalIndent.
+func (ss SliceType) JSONMarshalIndent(prefix, indent string) ([]byte, error) {
+	return pie.JSONMarshalIndent(ss, prefix, indent)
+}
+`,
+	},
+	"JSONString": {
+		Level: v2Ordered,
+		Code: `// JSONString returns the JSON encoded array as a string.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+func (ss SliceType) JSONString() string {
+	return pie.JSONString(ss)
+}
+`,
+	},
+	"JSONStringIndent": {
+		Level: v2Ordered,
+		Code: `// JSONStringIndent returns the JSON encoded array as a string with indent applied.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array. See
+// json.MarshalIndent for details.
+func (ss SliceType) JSONStringIndent(prefix, indent string) string {
+	return pie.JSONStringIndent(ss, prefix, indent)
+}
+`,
+	},
+	"Join": {
+		Level: v2Ordered,
+		Code: `// Join returns a string from joining each of the elements.
+func (ss SliceType) Join(glue string) string {
+	return pie.Join(ss, glue)
+}
+`,
+	},
+	"Last": {
+		Level: v2Any,
+		Code: `// Last returns the last element or a zero value if there are no elements.
+func (ss SliceType) Last() ElementType {
+	return pie.Last(ss)
+}
+`,
+	},
+	"LastOr": {
+		Level: v2Any,
+		Code: `// LastOr returns the last element or a default value if there are no elements.
+func (ss SliceType) LastOr(defaultValue ElementType) ElementType {
+	return pie.LastOr(ss, defaultValue)
+}
+`,
+	},
+	"LinearRegression": {
+		Level: v2Numeric,
+		Code: `// LinearRegression finds the straight line that best fits the points (xs[i],
+// ys[i]) using ordinary least squares.
+//
+// ErrUnequalLengths is returned if xs and ys are not the same length. If there
+// are no elements or all of the xs are the same, there is no single line, so
+// every value is NaN. If all of the ys are the same, the line is flat and
+// RSquared is 1.
+func (ss SliceType) LinearRegression(ys []ElementType) (pie.LinearFit, error) {
+	return pie.LinearRegression(ss, ys)
+}
+`,
+	},
+	"LongestRun": {
+		Level: v2Comparable,
+		Code: `// LongestRun returns the longest run of consecutive equal elements. If there is
+// more than one run with the longest length, the first one is returned:
+//
+//	LongestRun([a, b, b, c, c]) => {b, 2}
+//
+// The Count is zero if there are no elements.
+func (ss SliceType) LongestRun() pie.Run[ElementType] {
+	return pie.LongestRun(ss)
+}
+`,
+	},
+	"Map": {
+		Level: v2Any,
+		Code: `// Map will return a new slice where each element has been mapped (transformed).
+// The number of elements returned will always be the same as the input.
+//
+// Be careful when using this with slices of pointers. If you modify the input
+// value it will affect the original slice. Be sure to return a new allocated
+// object or deep copy the existing one.
+//
+// The element type can be changed by the mapping. Since Go methods cannot
+// introduce type parameters, the Map method of OfSlice (and friends) must
+// return the same type. To continue a chain after changing the type, pass the
+// Result through Map and wrap it again:
+//
+//	ids := pie.OfOrdered(pie.Map(pie.Of(users).Filter(isActive).Result, User.ID)).
+//	    Unique().
+//	    Result
+//
+// See also MapIndexed, FlatMap and FilterMap.
+func (ss SliceType) Map(fn func(ElementType) ElementType) SliceType {
+	return pie.Map(ss, fn)
+}
+`,
+	},
+	"MapIndexed": {
+		Level: v2Any,
+		Code: `// MapIndexed is the same as Map except the function also receives the index of
+// each element.
+//
+// The number of elements returned will always be the same as the input.
+func (ss SliceType) MapIndexed(fn func(int, ElementType) ElementType) SliceType {
+	return pie.MapIndexed(ss, fn)
+}
+`,
+	},
+	"Max": {
+		Level: v2Ordered,
+		Code: `// Max is the maximum value, or zero.
+func (ss SliceType) Max() ElementType {
+	return pie.Max(ss)
+}
+`,
+	},
+	"Median": {
+		Level: v2Numeric,
+		Code: `// Median returns the value separating the higher half from the lower half of a
+// data sample.
+//
+// Zero is returned if there are no elements in the slice.
+//
+// If the number of elements is even, then the ElementType mean of the two
+// "median values" is returned.
+func (ss SliceType) Median() ElementType {
+	return pie.Median(ss)
+}
+`,
+	},
+	"MedianAbsoluteDeviation": {
+		Level: v2Numeric,
+		Code: `// MedianAbsoluteDeviation is the median of the absolute differences between
+// each element and the Median of the elements. It is a measure of how spread
+// out the elements are, like Stddev, but is not affected by a few outliers.
+//
+// Unlike Median, the result is a float64 (even for integers) so the median is
+// not rounded. Multiply the result by 1.4826 to estimate the standard deviation
+// of normally distributed data.
+//
+// Zero is returned if there are no elements.
+func (ss SliceType) MedianAbsoluteDeviation() float64 {
+	return pie.MedianAbsoluteDeviation(ss)
+}
+`,
+	},
+	"Min": {
+		Level: v2Ordered,
+		Code: `// Min is the minimum value, or zero.
+func (ss SliceType) Min() ElementType {
+	return pie.Min(ss)
+}
+`,
+	},
+	"MinMaxScale": {
+		Level: v2Numeric,
+		Code: `// MinMaxScale scales the elements to the range [0, 1], where the smallest
+// element becomes 0 and the largest becomes 1:
+//
+//	MinMaxScale([10, 15, 20]) => [0, 0.5, 1]
+//
+// If all of the elements are the same (so there is no range to scale), every
+// value is 0. nil is returned if there are no elements in the slice.
+func (ss SliceType) MinMaxScale() []float64 {
+	return pie.MinMaxScale(ss)
+}
+`,
+	},
+	"Mode": {
+		Level: v2Comparable,
+		Code: `// Mode returns a new slice containing the most frequently occuring values.
+//
+// The number of items returned may be the same as the input or less. It will
+// never return zero items unless the input slice has zero items.
+func (ss SliceType) Mode() SliceType {
+	return pie.Mode(ss)
+}
+`,
+	},
+	"Multiply": {
+		Level: v2Numeric,
+		Code: `// Multiply returns the product of the elements at each position of ss1 and ss2
+// (the Hadamard product):
+//
+//	Multiply([1, 2, 3], [10, 20, 30]) => [10, 40, 90]
+//
+// ErrUnequalLengths is returned if ss1 and ss2 are not the same length. nil is
+// returned if there are no elements.
+func (ss SliceType) Multiply(ss2 []ElementType) ([]ElementType, error) {
+	return pie.Multiply(ss, ss2)
+}
+`,
+	},
+	"Norm": {
+		Level: v2Numeric,
+		Code: `// Norm returns the Euclidean length (the L2 norm) of ss, which is the square
+// root of the sum of the squares of the elements:
+//
+//	Norm([3, 4]) => 5
+//
+// The squares are added as float64s, so integers cannot overflow. Like Dot,
+// they are added to four separate totals. Zero is returned if there are no
+// elements. See NormalizeL2 to scale a slice to a length of 1.
+func (ss SliceType) Norm() float64 {
+	return pie.Norm(ss)
+}
+`,
+	},
+	"NormalizeL1": {
+		Level: v2Numeric,
+		Code: `// NormalizeL1 divides each element by the sum of the absolute values of the
+// elements (the L1 norm), so that the absolute values add up to 1:
+//
+//	NormalizeL1([1, -3, 4]) => [0.125, -0.375, 0.5]
+//
+// If every element is zero, every value is 0. nil is returned if there are no
+// elements in the slice.
+func (ss SliceType) NormalizeL1() []float64 {
+	return pie.NormalizeL1(ss)
+}
+`,
+	},
+	"NormalizeL2": {
+		Level: v2Numeric,
+		Code: `// NormalizeL2 divides each element by the Euclidean length of the elements
+// (the L2 norm), so that the result is a unit vector:
+//
+//	NormalizeL2([3, 4]) => [0.6, 0.8]
+//
+// If every element is zero, every value is 0. nil is returned if there are no
+// elements in the slice.
+func (ss SliceType) NormalizeL2() []float64 {
+	return pie.NormalizeL2(ss)
+}
+`,
+	},
+	"PearsonCorrelation": {
+		Level: v2Numeric,
+		Code: `// PearsonCorrelation is the Pearson correlation coefficient of xs and ys. It
+// measures how close they are to having a linear relationship, from -1 (as one
+// increases the other decreases) to 1 (they increase together). Zero means
+// there is no linear relationship.
+//
+// ErrUnequalLengths is returned if xs and ys are not the same length. NaN is
+// returned if either slice has no variation (including when there are fewer
+// than two elements).
+func (ss SliceType) PearsonCorrelation(ys []ElementType) (float64, error) {
+	return pie.PearsonCorrelation(ss, ys)
+}
+`,
+	},
+	"Permutations": {
+		Level: v2Any,
+		Code: `// Permutations returns every ordering of the elements in ss. See
+// PermutationsSeq for the order.
+//
+// There are n! permutations, so ErrTooManyResults is returned (without
+// generating any permutations) if there would be more than max.
+func (ss SliceType) Permutations(max int) ([][]ElementType, error) {
+	return pie.Permutations(ss, max)
+}
+`,
+	},
+	"PermutationsSeq": {
+		Level: v2Any,
+		Code: `// PermutationsSeq returns a sequence (that can be used as an iter.Seq) of every
+// ordering of the elements in ss. Elements are treated as different based on
+// their position, not their value, so equal elements produce repeated
+// permutations.
+//
+// The permutations are produced in lexicographic order of the positions:
+//
+//	PermutationsSeq([a, b, c]) => [a, b, c], [a, c, b], [b, a, c], [b, c, a], ...
+//
+// There are n! permutations, including a single empty permutation if ss is
+// empty. They are generated lazily and each one is a new slice.
+func (ss SliceType) PermutationsSeq() func(yield func([]ElementType) bool) {
+	return pie.PermutationsSeq(ss)
+}
+`,
+	},
+	"Pop": {
+		Level: v2Any,
+		Code: `// Pop the first element of the slice
+//
+// Usage Example:
+//
+//	type knownGreetings []string
+//	greetings := knownGreetings{"ciao", "hello", "hola"}
+//	for greeting := greetings.Pop(); greeting != nil; greeting = greetings.Pop() {
+//	    fmt.Println(*greeting)
+//	}
+func (ss *SliceType) Pop() *ElementType {
+	return pie.Pop((*[]ElementType)(ss))
+}
+`,
+	},
+	"PowerSet": {
+		Level: v2Any,
+		Code: `// PowerSet returns every subset of ss. See PowerSetSeq for the order.
+//
+// There are 2^n subsets, so ErrTooManyResults is returned (without generating
+// any subsets) if there would be more than max.
+func (ss SliceType) PowerSet(max int) ([][]ElementType, error) {
+	return pie.PowerSet(ss, max)
+}
+`,
+	},
+	"PowerSetSeq": {
+		Level: v2Any,
+		Code: `// PowerSetSeq returns a sequence (that can be used as an iter.Seq) of every
+// subset of ss, starting with the empty subset. The subsets are produced in
+// order of their size, and then in the same order as CombinationsSeq:
+//
+//	PowerSetSeq([a, b, c]) => [], [a], [b], [c], [a, b], [a, c], [b, c], [a, b, c]
+//
+// There are 2^n subsets. They are generated lazily and each one is a new slice.
+func (ss SliceType) PowerSetSeq() func(yield func([]ElementType) bool) {
+	return pie.PowerSetSeq(ss)
+}
+`,
+	},
+	"Product": {
+		Level: v2Numeric,
+		Code: `// Product is the product of all of the elements.
+func (ss SliceType) Product() ElementType {
+	return pie.Product(ss)
+}
+`,
+	},
+	"Random": {
+		Level:   v2Any,
+		Imports: []string{"math/rand"},
+		Code: `// Random returns a random element by your rand.Source. If the slice is empty,
+// the zero value and false are returned.
+func (ss SliceType) Random(source rand.Source) (ElementType, bool) {
+	return pie.Random(ss, source)
+}
+`,
+	},
+	"Reduce": {
+		Level: v2Any,
+		Code: `// Reduce continually applies the provided function
+// over the slice. Reducing the elements to a single value.
+//
+// Returns a zero value of T if there are no elements in the slice. It will
+// panic if the reducer is nil and the slice has more than one element (required
+// to invoke reduce). Otherwise returns result of applying reducer from left to
+// right.
+//
+// Use ReduceOk to distinguish an empty slice from a zero result, or Fold to
+// reduce into a different type with an explicit initial value.
+func (ss SliceType) Reduce(reducer func(ElementType, ElementType) ElementType) ElementType {
+	return pie.Reduce(ss, reducer)
+}
+`,
+	},
+	"ReduceOk": {
+		Level: v2Any,
+		Code: `// ReduceOk is the same as Reduce except that it also returns false if there
+// are no elements in the slice. This allows a legitimate zero value result to
+// be distinguished from an empty input.
+func (ss SliceType) ReduceOk(reducer func(ElementType, ElementType) ElementType) (ElementType, bool) {
+	return pie.ReduceOk(ss, reducer)
+}
+`,
+	},
+	"ReduceRight": {
+		Level: v2Any,
+		Code: `// ReduceRight is the same as Reduce except the elements are reduced from right
+// to left. The last element is used as the initial value.
+//
+// Returns a zero value of T if there are no elements in the slice.
+func (ss SliceType) ReduceRight(reducer func(ElementType, ElementType) ElementType) ElementType {
+	return pie.ReduceRight(ss, reducer)
+}
+`,
+	},
+	"Reverse": {
+		Level: v2Any,
+		Code: `// Reverse returns a new copy of the slice with the elements ordered in reverse.
+// This is useful when combined with Sort to get a descending sort order:
+//
+//	ss.Sort().Reverse()
+func (ss SliceType) Reverse() SliceType {
+	return pie.Reverse(ss)
+}
+`,
+	},
+	"RollingMax": {
+		Level: v2Ordered,
+		Code: `// RollingMax returns the maximum value of each window of consecutive elements.
+//
+// The result contains one value for every complete window, that is
+// len(ss)-window+1 values. nil is returned if the slice has fewer elements
+// than the window. It will panic if window is less than 1.
+//
+// This runs in O(n) regardless of the window size.
+//
+// Examples:
+//
+//	RollingMax([4, 2, 12, 3, 8], 2) => [4, 12, 12, 8]
+//	RollingMax([4, 2, 12, 3, 8], 3) => [12, 12, 12]
+func (ss SliceType) RollingMax(window int) SliceType {
+	return pie.RollingMax(ss, window)
+}
+`,
+	},
+	"RollingMean": {
+		Level: v2Numeric,
+		Code: `// RollingMean returns the average of each window of consecutive elements. This
+// is also known as the simple moving average.
+//
+// The result contains one value for every complete window, that is
+// len(ss)-window+1 values. nil is returned if the slice has fewer elements
+// than the window. It will panic if window is less than 1.
+//
+// Examples:
+//
+//	RollingMean([1, 2, 3, 4, 5], 2) => [1.5, 2.5, 3.5, 4.5]
+//	RollingMean([1, 2, 3, 4, 5], 5) => [3]
+func (ss SliceType) RollingMean(window int) []float64 {
+	return pie.RollingMean(ss, window)
+}
+`,
+	},
+	"RollingMedian": {
+		Level: v2Numeric,
+		Code: `// RollingMedian returns the median of each window of consecutive elements. See
+// Median for how the median is calculated for an even sized window.
+//
+// The result contains one value for every complete window, that is
+// len(ss)-window+1 values. nil is returned if the slice has fewer elements
+// than the window. It will panic if window is less than 1.
+//
+// The median of a window that contains NaN is NaN.
+//
+// Examples:
+//
+//	RollingMedian([1, 5, 2, 8, 3], 3) => [2, 5, 3]
+//	RollingMedian([1, 5, 2, 8, 3], 2) => [3, 3, 5, 5]
+func (ss SliceType) RollingMedian(window int) SliceType {
+	return pie.RollingMedian(ss, window)
+}
+`,
+	},
+	"RollingMin": {
+		Level: v2Ordered,
+		Code: `// RollingMin returns the minimum value of each window of consecutive elements.
+//
+// The result contains one value for every complete window, that is
+// len(ss)-window+1 values. nil is returned if the slice has fewer elements
+// than the window. It will panic if window is less than 1.
+//
+// This runs in O(n) regardless of the window size.
+//
+// Examples:
+//
+//	RollingMin([4, 2, 12, 3, 8], 2) => [2, 2, 3, 3]
+//	RollingMin([4, 2, 12, 3, 8], 3) => [2, 2, 3]
+func (ss SliceType) RollingMin(window int) SliceType {
+	return pie.RollingMin(ss, window)
+}
+`,
+	},
+	"RollingSum": {
+		Level: v2Numeric,
+		Code: `// RollingSum returns the sum of each window of consecutive elements.
+//
+// The result contains one value for every complete window, that is
+// len(ss)-window+1 values. nil is returned if the slice has fewer elements
+// than the window. It will panic if window is less than 1.
+//
+// Examples:
+//
+//	RollingSum([1, 2, 3, 4, 5], 2) => [3, 5, 7, 9]
+//	RollingSum([1, 2, 3, 4, 5], 3) => [6, 9, 12]
+//	RollingSum([1, 2], 3)          => []
+func (ss SliceType) RollingSum(window int) SliceType {
+	return pie.RollingSum(ss, window)
+}
+`,
+	},
+	"Rotate": {
+		Level: v2Any,
+		Code: `// Rotate return slice circularly rotated by a number of positions n.
+// If n is positive, the slice is rotated right.
+// If n is negative, the slice is rotated left.
+func (ss SliceType) Rotate(n int) SliceType {
+	return pie.Rotate(ss, n)
+}
+`,
+	},
+	"Round": {
+		Level: v2Numeric,
+		Code: `// Round returns a new slice with each element rounded to the number of decimal
+// places, with halves rounded away from zero:
+//
+//	Round([1.234, 5.678, -2.5], 1) => [1.2, 5.7, -2.5]
+//	Round([1.234, 5.678, -2.5], 0) => [1, 6, -3]
+//
+// A negative number of places rounds to the left of the decimal point, so it
+// also works for integers:
+//
+//	Round([1234, 1250, -1250], -2) => [1200, 1300, -1300]
+//
+// Integers are unchanged when places is zero or more. Rounded integers that
+// are too large for T wrap around, the same as other integer arithmetic. nil is
+// returned if there are no elements in the slice.
+func (ss SliceType) Round(places int) SliceType {
+	return pie.Round(ss, places)
+}
+`,
+	},
+	"RunLengthEncode": {
+		Level: v2Comparable,
+		Code: `// RunLengthEncode returns a Run for each run of consecutive equal elements:
+//
+//	RunLengthEncode([a, a, b, a, a, a]) => [{a, 2}, {b, 1}, {a, 3}]
+//
+// Use RunLengthDecode to get the original elements back. nil is returned if
+// there are no elements.
+func (ss SliceType) RunLengthEncode() []pie.Run[ElementType] {
+	return pie.RunLengthEncode(ss)
+}
+`,
+	},
+	"Sample": {
+		Level:   v2Any,
+		Imports: []string{"math/rand"},
+		Code: `// Sample returns k different elements chosen at random by your rand.Source.
+// Each element can only be chosen once, although equal values may appear more
+// than once if they are in the input more than once.
+//
+// The chosen elements are returned in the same order that they appear in ss.
+// If k is greater than or equal to the length of ss a copy of ss is returned,
+// and if k is less than 1 nil is returned.
+//
+// Sample uses Floyd's algorithm, so it only needs k random numbers regardless
+// of the length of ss.
+func (ss SliceType) Sample(k int, source rand.Source) SliceType {
+	return pie.Sample(ss, k, source)
+}
+`,
+	},
+	"SampleWithReplacement": {
+		Level:   v2Any,
+		Imports: []string{"math/rand"},
+		Code: `// SampleWithReplacement returns k elements chosen at random by your
+// rand.Source. Unlike Sample, the same element may be chosen more than once and
+// k can be greater than the length of ss.
+//
+// If ss is empty or k is less than 1, nil is returned.
+func (ss SliceType) SampleWithReplacement(k int, source rand.Source) SliceType {
+	return pie.SampleWithReplacement(ss, k, source)
+}
+`,
+	},
+	"Scale": {
+		Level: v2Numeric,
+		Code: `// Scale returns a new slice with each element multiplied by factor:
+//
+//	Scale([1, 2, 3], 10) => [10, 20, 30]
+//
+// nil is returned if there are no elements.
+func (ss SliceType) Scale(factor ElementType) SliceType {
+	return pie.Scale(ss, factor)
+}
+`,
+	},
+	"Scan": {
+		Level: v2Any,
+		Code: `// Scan is like Reduce, but returns every intermediate accumulator rather than
+// only the final one. The accumulator starts at initial and may be a different
+// type to the elements.
+//
+// The result has the same length as ss, where each value is the accumulator
+// after applying fn to the element at the same position. The initial value is
+// not included. nil is returned if there are no elements in the slice.
+//
+// Examples:
+//
+//	Scan([1, 2, 3], 10, +)                  => [11, 13, 16]
+//	Scan(["a", "b", "c"], "", concatenate)  => ["a", "ab", "abc"]
+func (ss SliceType) Scan(initial ElementType, fn func(ElementType, ElementType) ElementType) SliceType {
+	return pie.Scan(ss, initial, fn)
+}
+`,
+	},
+	"Send": {
+		Level:   v2Any,
+		Imports: []string{"context"},
+		Code: `// Send sends elements to channel
+// in normal act it sends all elements but if func canceled it can be less
+//
+// it locks execution of gorutine
+// it doesn't close channel after work
+// returns sent elements if len(this) != len(old) considered func was canceled
+func (ss SliceType) Send(ctx context.Context, ch chan<- ElementType) SliceType {
+	return pie.Send(ctx, ss, ch)
+}
+`,
+	},
+	"Sequence": {
+		Level: v2Numeric,
+		Code: `// Sequence generates all numbers in range or returns nil if params invalid
+//
+// There are 3 variations to generate:
+//  1. [0, n).
+//  2. [min, max).
+//  3. [min, max) with step.
+//
+// if len(params) == 1 considered that will be returned slice between 0 and n,
+// where n is the first param, [0, n).
+// if len(params) == 2 considered that will be returned slice between min and max,
+// where min is the first param, max is the second, [min, max).
+// if len(params) > 2 considered that will be returned slice between min and max with step,
+// where min is the first param, max is the second, step is the third one, [min, max) with step,
+// others params will be ignored
+func (ss SliceType) Sequence(params ...int) SliceType {
+	return pie.Sequence(ss, params...)
+}
+`,
+	},
+	"SequenceUsing": {
+		Level: v2Any,
+		Code: `// SequenceUsing generates slice in range using creator function
+//
+// There are 3 variations to generate:
+//  1. [0, n).
+//  2. [min, max).
+//  3. [min, max) with step.
+//
+// if len(params) == 1 considered that will be returned slice between 0 and n,
+// where n is the first param, [0, n).
+// if len(params) == 2 considered that will be returned slice between min and max,
+// where min is the first param, max is the second, [min, max).
+// if len(params) > 2 considered that will be returned slice between min and max with step,
+// where min is the first param, max is the second, step is the third one, [min, max) with step,
+// others params will be ignored
+func (ss SliceType) SequenceUsing(creator func(int) ElementType, params ...int) SliceType {
+	return pie.SequenceUsing(ss, creator, params...)
+}
+`,
+	},
+	"Shift": {
+		Level: v2Any,
+		Code: `// Shift will return two values: the shifted value and the rest slice.
+// if the slice is empty then returned shifted value is the zero value of the slice elements and the rest slice is empty slice
+func (ss SliceType) Shift() (ElementType, []ElementType) {
+	return pie.Shift(ss)
+}
+`,
+	},
+	"Shuffle": {
+		Level:   v2Any,
+		Imports: []string{"math/rand"},
+		Code: `// Shuffle returns a new shuffled slice by your rand.Source. The original slice
+// is not modified.
+func (ss SliceType) Shuffle(source rand.Source) SliceType {
+	return pie.Shuffle(ss, source)
+}
+`,
+	},
+	"ShuffleSecure": {
+		Level: v2Any,
+		Code: `// ShuffleSecure returns a new shuffled slice using crypto/rand. It is suitable
+// for shuffles that must not be predictable, such as lottery draws. Each order
+// is equally likely. The original slice is not modified.
+//
+// An error is only returned if crypto/rand fails.
+func (ss SliceType) ShuffleSecure() ([]ElementType, error) {
+	return pie.ShuffleSecure(ss)
+}
+`,
+	},
+	"ShuffleSeed": {
+		Level: v2Any,
+		Code: `// ShuffleSeed returns a new shuffled slice that is always the same for the
+// same seed. The original slice is not modified.
+//
+// Unlike Shuffle, the order does not depend on the math/rand implementation.
+// It uses SplitMix64 for the random numbers, Lemire's method to choose each
+// index and a Fisher-Yates shuffle (swapping from the last element down). The
+// order for a seed and slice length will not change between versions of pie
+// or Go, so it is safe to use in tests or to store the seed to reproduce a
+// shuffle later.
+func (ss SliceType) ShuffleSeed(seed uint64) SliceType {
+	return pie.ShuffleSeed(ss, seed)
+}
+`,
+	},
+	"ShuffleWith": {
+		Level: v2Any,
+		Code: `// ShuffleWith returns a new shuffled slice by your Uint64Source. The original
+// slice is not modified. Unlike Shuffle, it does not need to create a
+// rand.Rand for each call.
+func (ss SliceType) ShuffleWith(source pie.Uint64Source) SliceType {
+	return pie.ShuffleWith(ss, source)
+}
+`,
+	},
+	"Sort": {
+		Level: v2Ordered,
+		Code: `// Sort works similar to sort.SliceType(). However, unlike sort.SliceType the
+// slice returned will be reallocated as to not modify the input slice.
+//
+// See Reverse() and AreSorted().
+func (ss SliceType) Sort() SliceType {
+	return pie.Sort(ss)
+}
+`,
+	},
+	"SortStableUsing": {
+		Level: v2Comparable,
+		Code: `// SortStableUsing works similar to sort.SliceStable. However, unlike sort.SliceStable the
+// slice returned will be reallocated as to not modify the input slice.
+func (ss SliceType) SortStableUsing(less func(a, b ElementType) bool) SliceType {
+	return pie.SortStableUsing(ss, less)
+}
+`,
+	},
+	"SortUsing": {
+		Level: v2Any,
+		Code: `// SortUsing works similar to sort.Slice. However, unlike sort.Slice the
+// slice returned will be reallocated as to not modify the input slice.
+func (ss SliceType) SortUsing(less func(a, b ElementType) bool) SliceType {
+	return pie.SortUsing(ss, less)
+}
+`,
+	},
+	"Sparkline": {
+		Level: v2Numeric,
+		Code: `// Sparkline returns a single line that shows the shape of the elements, with
+// one block character per element scaled between the smallest and largest
+// element:
+//
+//	Sparkline([1, 2, 3, 4, 5, 6, 7, 8, 7, 1]) => "▁▂▃▄▅▆▇█▇▁"
+//
+// NaN and infinite values are shown as a space. If all of the elements are the
+// same, they are shown as the smallest block.
+func (ss SliceType) Sparkline() string {
+	return pie.Sparkline(ss)
+}
+`,
+	},
+	"SpearmanRankCorrelation": {
+		Level: v2Numeric,
+		Code: `// SpearmanRankCorrelation is the Spearman rank correlation coefficient of xs
+// and ys. It is the PearsonCorrelation of the ranks of the elements, so it
+// measures how close they are to always increasing (1) or decreasing (-1)
+// together, even when the relationship is not linear. Equal elements are given
+// the average of their ranks.
+//
+// ErrUnequalLengths is returned if xs and ys are not the same length. NaN is
+// returned if either slice has no variation (including when there are fewer
+// than two elements).
+func (ss SliceType) SpearmanRankCorrelation(ys []ElementType) (float64, error) {
+	return pie.SpearmanRankCorrelation(ss, ys)
+}
+`,
+	},
+	"Stddev": {
+		Level: v2Numeric,
+		Code: `// Stddev is the standard deviation
+func (ss SliceType) Stddev() float64 {
+	return pie.Stddev(ss)
+}
+`,
+	},
+	"Strings": {
+		Level: v2Ordered,
+		Code: `// Strings transforms each element to a string.
+//
+// If the element type implements fmt.Stringer it will be used. Otherwise it
+// will fallback to the result of:
+//
+//	fmt.Sprintf("%v")
+func (ss SliceType) Strings() []string {
+	return pie.Strings(ss)
+}
+`,
+	},
+	"StringsUsing": {
+		Level: v2Any,
+		Code: `// StringsUsing transforms each element to a string.
+func (ss SliceType) StringsUsing(transform func(ElementType) string) []string {
+	return pie.StringsUsing(ss, transform)
+}
+`,
+	},
+	"SubSlice": {
+		Level: v2Any,
+		Code: `// SubSlice will return the subSlice from start to end(excluded)
+//
+// Condition 1: If start < 0 or end < 0, nil is returned.
+// Condition 2: If start >= end, nil is returned.
+// Condition 3: Return all elements that exist in the range provided,
+// if start or end is out of bounds, zero items will be placed.
+func (ss SliceType) SubSlice(start int, end int) SliceType {
+	return pie.SubSlice(ss, start, end)
+}
+`,
+	},
+	"Subtract": {
+		Level: v2Numeric,
+		Code: `// Subtract returns the elements of ss1 minus the element at the same position of
+// ss2:
+//
+//	Subtract([10, 20, 30], [1, 2, 3]) => [9, 18, 27]
+//
+// ErrUnequalLengths is returned if ss1 and ss2 are not the same length. nil is
+// returned if there are no elements.
+func (ss SliceType) Subtract(ss2 []ElementType) ([]ElementType, error) {
+	return pie.Subtract(ss, ss2)
+}
+`,
+	},
+	"Sum": {
+		Level: v2Numeric,
+		Code: `// Sum is the sum of all of the elements.
+func (ss SliceType) Sum() ElementType {
+	return pie.Sum(ss)
+}
+`,
+	},
+	"Table": {
+		Level:   v2Any,
+		Imports: []string{"io"},
+		Code: `// Table writes ss to w as a table with a header row, aligning the columns so
+// that they are easy to read in a terminal:
+//
+//	+----+------+
+//	| ID | Name |
+//	+----+------+
+//	|  1 | Bob  |
+//	| 23 | Jane |
+//	+----+------+
+//
+// If no columns are provided and T is a struct (or a pointer to a struct),
+// there will be a column for each exported field. Otherwise, there will be a
+// single column called "Value".
+//
+// Widths are counted in runes, so characters that are displayed wider (or
+// narrower) than others may not line up. New lines in cells are replaced with
+// spaces.
+func (ss SliceType) Table(w io.Writer, style pie.TableStyle, columns ...pie.TableColumn[ElementType]) error {
+	return pie.Table(w, ss, style, columns...)
+}
+`,
+	},
+	"ToCSV": {
+		Level:   v2Any,
+		Imports: []string{"io"},
+		Code: `// ToCSV writes ss to w as CSV with a header row, followed by one row for each
+// element. The header is always written, even if ss is empty.
+//
+// If no columns are provided, the columns from CSVFields are used, so T must
+// be a struct or a pointer to a struct. Otherwise, the columns are written in
+// the order they are provided:
+//
+//	pie.ToCSV(w, users,
+//		pie.CSVColumn[User]{Name: "id", Value: func(u User) string { return u.ID }},
+//		pie.CSVColumn[User]{Name: "email", Value: func(u User) string { return u.Email }},
+//	)
+//
+// A *CSVError is returned if a field from CSVFields cannot be formatted.
+func (ss SliceType) ToCSV(w io.Writer, columns ...pie.CSVColumn[ElementType]) error {
+	return pie.ToCSV(w, ss, columns...)
+}
+`,
+	},
+	"Top": {
+		Level: v2Any,
+		Code: `// Top will return n elements from head of the slice
+// if the slice has less elements then n that'll return all elements
+// if n < 0 it'll return empty slice.
+func (ss SliceType) Top(n int) SliceType {
+	return pie.Top(ss, n)
+}
+`,
+	},
+	"TrimmedMean": {
+		Level: v2Numeric,
+		Code: `// TrimmedMean is the average of the elements after removing the smallest and
+// largest fraction of them, which makes it less sensitive to outliers than
+// Average. For example, a fraction of 0.1 ignores the lowest 10% and highest
+// 10% of the elements:
+//
+//	TrimmedMean([1, 2, 3, 4, 5, 6, 7, 8, 9, 1000], 0.1) => 5.5
+//
+// The number of elements removed from each end is rounded down. Zero is
+// returned if there are no elements. It will panic if fraction is not in the
+// range [0, 0.5).
+func (ss SliceType) TrimmedMean(fraction float64) float64 {
+	return pie.TrimmedMean(ss, fraction)
+}
+`,
+	},
+	"Unique": {
+		Level: v2Comparable,
+		Code: `// Unique returns a new slice with all of the unique values.
+//
+// The items will be returned in a randomized order, even with the same input.
+//
+// The number of items returned may be the same as the input or less. It will
+// never return zero items unless then input slice has zero items.
+//
+// A slice with zero elements is considered to be unique.
+//
+// See AreUnique(). Use Dedup to only remove consecutive duplicates.
+func (ss SliceType) Unique() SliceType {
+	return pie.Unique(ss)
+}
+`,
+	},
+	"UniqueStable": {
+		Level: v2Comparable,
+		Code: `// UniqueStable works similar to Unique. However, unlike Unique
+// the slice returned will be in previous relative order
+func (ss SliceType) UniqueStable() SliceType {
+	return pie.UniqueStable(ss)
+}
+`,
+	},
+	"Unshift": {
+		Level: v2Any,
+		Code: `// Unshift adds one or more elements to the beginning of the slice
+// and returns the new slice.
+func (ss SliceType) Unshift(elements ...ElementType) SliceType {
+	return pie.Unshift(ss, elements...)
+}
+`,
+	},
+	"WeightedAverage": {
+		Level: v2Numeric,
+		Code: `// WeightedAverage is the average of the elements where each element counts
+// weights[i] times, that is sum(ss[i] * weights[i]) / sum(weights):
+//
+//	WeightedAverage([1, 2, 3], [3, 1, 0]) => 1.25
+//
+// ErrUnequalLengths is returned if ss and weights are not the same length.
+// Zero is returned if the weights add up to zero, including when there are no
+// elements.
+func (ss SliceType) WeightedAverage(weights []float64) (float64, error) {
+	return pie.WeightedAverage(ss, weights)
+}
+`,
+	},
+	"WeightedChoice": {
+		Level:   v2Any,
+		Imports: []string{"math/rand"},
+		Code: `// WeightedChoice returns a random element by your rand.Source, where the chance
+// of each element being chosen is proportional to its weight. Elements with a
+// weight of zero or less are never chosen.
+//
+// If there are no elements with a positive weight, the zero value and false
+// are returned.
+func (ss SliceType) WeightedChoice(weight func(ElementType) float64, source rand.Source) (ElementType, bool) {
+	return pie.WeightedChoice(ss, weight, source)
+}
+`,
+	},
+	"WeightedSample": {
+		Level:   v2Any,
+		Imports: []string{"math/rand"},
+		Code: `// WeightedSample returns k different elements chosen at random by your
+// rand.Source, where the chance of each element being chosen is proportional
+// to its weight. Elements with a weight of zero or less are never chosen, so
+// fewer than k elements are returned if there are not enough elements with a
+// positive weight.
+//
+// Like Sample, each element can only be chosen once and the chosen elements are
+// returned in the same order that they appear in ss. If k is less than 1, nil
+// is returned.
+//
+// WeightedSample uses the algorithm by Efraimidis and Spirakis, which gives
+// each element a random key based on its weight and chooses the k largest
+// keys.
+func (ss SliceType) WeightedSample(k int, weight func(ElementType) float64, source rand.Source) SliceType {
+	return pie.WeightedSample(ss, k, weight, source)
+}
+`,
+	},
+	"WriteJSONLines": {
+		Level:   v2Any,
+		Imports: []string{"io"},
+		Code: `// WriteJSONLines writes each element of ss to w as a single line of JSON (also
+// known as JSON Lines or NDJSON). Elements are encoded and written one at a
+// time, so the whole output is never held in memory.
+//
+// Nothing is written for an empty slice. If an element cannot be encoded, the
+// elements before it will have already been written and the error includes the
+// index of the element.
+func (ss SliceType) WriteJSONLines(w io.Writer) error {
+	return pie.WriteJSONLines(w, ss)
+}
+`,
+	},
+	"ZScore": {
+		Level: v2Numeric,
+		Code: `// ZScore returns the number of standard deviations each element is from the
+// mean, using the population standard deviation (like Stddev):
+//
+//	ZScore([2, 4, 4, 4, 5, 5, 7, 9]) => [-1.5, -0.5, -0.5, -0.5, 0, 0, 1, 2]
+//
+// The mean and standard deviation are calculated with float64, so they cannot
+// overflow for small integer types.
+//
+// If all of the elements are the same (so the standard deviation is zero),
+// every value is 0. nil is returned if there are no elements in the slice.
+func (ss SliceType) ZScore() []float64 {
+	return pie.ZScore(ss)
+}
+`,
+	},
+	"Zip": {
+		Level: v2Any,
+		Code: `// Zip will return a new slice containing pairs with elements from input slices.
+// If input slices have diffrent length, the output slice will be truncated to
+// the length of the smallest input slice.
+func (ss SliceType) Zip(ss2 []ElementType) []pie.Zipped[ElementType, ElementType] {
+	return pie.Zip(ss, ss2)
+}
+`,
+	},
+	"Zip3": {
+		Level: v2Any,
+		Code: `// Zip3 works like Zip for three slices. The output slice will be truncated to
+// the length of the smallest input slice.
+func (ss SliceType) Zip3(ss2 []ElementType, ss3 []ElementType) []pie.Zipped3[ElementType, ElementType, ElementType] {
+	return pie.Zip3(ss, ss2, ss3)
+}
+`,
+	},
+	"ZipLongest": {
+		Level: v2Any,
+		Code: `// ZipLongest will return a new slice containing pairs with elements from input slices.
+// If input slices have diffrent length, missing elements will be padded with default values.
+func (ss SliceType) ZipLongest(ss2 []ElementType) []pie.Zipped[ElementType, ElementType] {
+	return pie.ZipLongest(ss, ss2)
+}
+`,
+	},
+	"ZipLongestWith": {
+		Level: v2Any,
+		Code: `// ZipLongestWith works like ZipLongest, except that missing elements are
+// padded with fill1 (when ss1 is shorter) or fill2 (when ss2 is shorter)
+// instead of the zero value.
+func (ss SliceType) ZipLongestWith(ss2 []ElementType, fill1 ElementType, fill2 ElementType) []pie.Zipped[ElementType, ElementType] {
+	return pie.ZipLongestWith(ss, ss2, fill1, fill2)
+}
+`,
+	},
+	"ZipStrict": {
+		Level: v2Any,
+		Code: `// ZipStrict works like Zip, except that it returns ErrUnequalLengths (and a nil
+// slice) if ss1 and ss2 are not the same length, rather than truncating.
+func (ss SliceType) ZipStrict(ss2 []ElementType) ([]pie.Zipped[ElementType, ElementType], error) {
+	return pie.ZipStrict(ss, ss2)
+}
+`,
+	},
+	"ZipWith": {
+		Level: v2Any,
+		Code: `// ZipWith returns a new slice containing the result of fn for each pair of
+// elements from ss1 and ss2. It is the same as mapping the result of Zip, but
+// without creating the pairs:
+//
+//	ZipWith([1, 2, 3], [10, 20], add) => [11, 22]
+//
+// If the input slices have different lengths, the output slice will be
+// truncated to the length of the smallest input slice.
+func (ss SliceType) ZipWith(ss2 []ElementType, fn func(ElementType, ElementType) ElementType) SliceType {
+	return pie.ZipWith(ss, ss2, fn)
+}
+`,
+	},
+}
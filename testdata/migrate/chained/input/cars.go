@@ -0,0 +1,31 @@
+package cars
+
+import (
+	"strings"
+
+	"github.com/elliotchance/pie/pie"
+)
+
+// Cars is a slice of cars.
+//
+//go:generate pie Cars.FilterNot.StringsUsing.Contains.Len
+type Cars []Car
+
+type Car struct {
+	Name, Color string
+}
+
+// RedNames returns the upper case names of the red cars, sorted.
+func RedNames(cars Cars) []string {
+	names := cars.FilterNot(func(c Car) bool {
+		return c.Color != "red"
+	}).StringsUsing(func(c Car) string {
+		return c.Name
+	})
+
+	return pie.Strings(names).Sort().Map(strings.ToUpper)
+}
+
+func HasCars(cars *Cars, car Car) bool {
+	return cars.Len() > 0 && cars.Contains(car)
+}
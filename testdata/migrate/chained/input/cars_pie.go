@@ -0,0 +1,53 @@
+package cars
+
+import (
+	"github.com/elliotchance/pie/pie"
+)
+
+// Contains returns true if the element exists in the slice.
+//
+// When using slices of pointers it will only compare by address, not value.
+func (ss Cars) Contains(lookingFor Car) bool {
+	for _, s := range ss {
+		if lookingFor == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FilterNot works the same as Filter, with a negated condition. That is, it will
+// return a new slice only containing the elements that returned false from the
+// condition. The returned slice may contain zero elements (nil).
+func (ss Cars) FilterNot(condition func(Car) bool) (ss2 Cars) {
+	for _, s := range ss {
+		if !condition(s) {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
+
+// Len returns the number of elements.
+func (ss Cars) Len() int {
+	return len(ss)
+}
+
+// StringsUsing transforms each element to a string.
+func (ss Cars) StringsUsing(transform func(Car) string) pie.Strings {
+	l := len(ss)
+
+	// Avoid the allocation.
+	if l == 0 {
+		return nil
+	}
+
+	result := make(pie.Strings, l)
+	for i := 0; i < l; i++ {
+		result[i] = transform(ss[i])
+	}
+
+	return result
+}
@@ -0,0 +1,29 @@
+package cars
+
+import (
+	"strings"
+
+	"github.com/elliotchance/pie/v2"
+)
+
+// Cars is a slice of cars.
+type Cars []Car
+
+type Car struct {
+	Name, Color string
+}
+
+// RedNames returns the upper case names of the red cars, sorted.
+func RedNames(cars Cars) []string {
+	names := pie.StringsUsing(pie.FilterNot(cars, func(c Car) bool {
+		return c.Color != "red"
+	}), func(c Car) string {
+		return c.Name
+	})
+
+	return pie.Map(pie.Sort([]string(names)), strings.ToUpper)
+}
+
+func HasCars(cars *Cars, car Car) bool {
+	return len(*cars) > 0 && pie.Contains(*cars, car)
+}
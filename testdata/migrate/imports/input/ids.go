@@ -0,0 +1,4 @@
+package ids
+
+//go:generate pie IDs.Contains.Unique
+type IDs []int
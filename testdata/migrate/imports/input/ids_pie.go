@@ -0,0 +1,45 @@
+package ids
+
+// Contains returns true if the element exists in the slice.
+//
+// When using slices of pointers it will only compare by address, not value.
+func (ss IDs) Contains(lookingFor int) bool {
+	for _, s := range ss {
+		if lookingFor == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Unique returns a new slice with all of the unique values.
+//
+// The items will be returned in a randomized order, even with the same input.
+//
+// The number of items returned may be the same as the input or less. It will
+// never return zero items unless then input slice has zero items.
+//
+// A slice with zero elements is considered to be unique.
+//
+// See AreUnique().
+func (ss IDs) Unique() IDs {
+	// Avoid the allocation. If there is one element or less it is already
+	// unique.
+	if len(ss) < 2 {
+		return ss
+	}
+
+	values := map[int]struct{}{}
+
+	for _, value := range ss {
+		values[value] = struct{}{}
+	}
+
+	var uniqueValues IDs
+	for value := range values {
+		uniqueValues = append(uniqueValues, value)
+	}
+
+	return uniqueValues
+}
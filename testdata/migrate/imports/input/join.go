@@ -0,0 +1,12 @@
+package ids
+
+import (
+	"strings"
+
+	"github.com/elliotchance/pie/pie"
+)
+
+// Join only uses a type from pie, so the import is removed.
+func Join(names pie.Strings) string {
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,12 @@
+package ids
+
+import "fmt"
+
+// Has did not import pie, so the import is added.
+func Has(ids IDs, id int) bool {
+	return ids.Unique().Contains(id)
+}
+
+func Describe(ids IDs) string {
+	return fmt.Sprintf("%d unique", len(ids.Unique()))
+}
@@ -0,0 +1,3 @@
+package ids
+
+type IDs []int
@@ -0,0 +1,8 @@
+package ids
+
+import "strings"
+
+// Join only uses a type from pie, so the import is removed.
+func Join(names []string) string {
+	return strings.Join(names, ", ")
+}
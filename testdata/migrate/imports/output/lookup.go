@@ -0,0 +1,15 @@
+package ids
+
+import (
+	"fmt"
+	"github.com/elliotchance/pie/v2"
+)
+
+// Has did not import pie, so the import is added.
+func Has(ids IDs, id int) bool {
+	return pie.Contains(pie.Unique(ids), id)
+}
+
+func Describe(ids IDs) string {
+	return fmt.Sprintf("%d unique", len(pie.Unique(ids)))
+}
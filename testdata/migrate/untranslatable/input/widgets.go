@@ -0,0 +1,25 @@
+package widgets
+
+import "github.com/elliotchance/pie/pie"
+
+//go:generate pie Widgets.Filter.Append.Contains
+type Widgets []Widget
+
+type Widget struct {
+	Name string
+}
+
+func (w Widget) Equals(w2 Widget) bool {
+	return w.Name == w2.Name
+}
+
+func Problems(widgets Widgets, w Widget) (Widgets, bool) {
+	keep := widgets.Filter
+	_ = keep
+
+	return widgets.Append(w), widgets.Contains(w)
+}
+
+func Abs(ints pie.Ints) pie.Ints {
+	return ints.Abs()
+}
@@ -0,0 +1,39 @@
+package widgets
+
+// Append will return a new slice with the elements appended to the end.
+//
+// It is acceptable to provide zero arguments.
+func (ss Widgets) Append(elements ...Widget) Widgets {
+	// Copy ss, to make sure no memory is overlapping between input and
+	// output. See issue #97.
+	result := append(Widgets{}, ss...)
+
+	result = append(result, elements...)
+	return result
+}
+
+// Contains returns true if the element exists in the slice.
+//
+// When using slices of pointers it will only compare by address, not value.
+func (ss Widgets) Contains(lookingFor Widget) bool {
+	for _, s := range ss {
+		if lookingFor.Equals(s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Filter will return a new slice containing only the elements that return
+// true from the condition. The returned slice may contain zero elements (nil).
+//
+// FilterNot works in the opposite way of Filter.
+func (ss Widgets) Filter(condition func(Widget) bool) (ss2 Widgets) {
+	for _, s := range ss {
+		if condition(s) {
+			ss2 = append(ss2, s)
+		}
+	}
+	return
+}
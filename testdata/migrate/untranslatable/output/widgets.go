@@ -0,0 +1,24 @@
+package widgets
+
+import "github.com/elliotchance/pie/v2"
+
+type Widgets []Widget
+
+type Widget struct {
+	Name string
+}
+
+func (w Widget) Equals(w2 Widget) bool {
+	return w.Name == w2.Name
+}
+
+func Problems(widgets Widgets, w Widget) (Widgets, bool) {
+	keep := widgets.Filter
+	_ = keep
+
+	return widgets.Append(w), pie.Contains(widgets, w)
+}
+
+func Abs(ints []int) []int {
+	return ints.Abs()
+}
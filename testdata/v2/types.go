@@ -0,0 +1,72 @@
+package shapes
+
+type Point struct {
+	X, Y int
+}
+
+type Points []Point
+
+type PointPointers []*Point
+
+// Grid is comparable because arrays are comparable.
+type Grid struct {
+	Cells [4]int
+}
+
+type Grids []Grid
+
+type Path struct {
+	Steps []Point
+}
+
+type Paths []Path
+
+type Index struct {
+	ByName map[string]int
+}
+
+type Indexes []Index
+
+type Handler struct {
+	Handle func()
+}
+
+type Handlers []Handler
+
+// Board is not comparable because the elements of the array are slices.
+type Board struct {
+	Rows [3][]int
+}
+
+type Boards []Board
+
+type Label struct {
+	Inner struct {
+		Names []string
+	}
+}
+
+type Labels []Label
+
+type Names []string
+
+type Scores []float64
+
+// Tagged is not comparable because Tags is a slice, although it is named.
+type Tagged struct {
+	X    int
+	Tags Tags
+}
+
+type Tags []string
+
+type TaggedPoints []Tagged
+
+// Name is ordered because its underlying type is a string.
+type Name string
+
+type FullNames []Name
+
+type Celsius float64
+
+type Temperatures []Celsius
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"sort"
+	"strings"
+)
+
+// getV2Level type checks the package and returns the most restrictive
+// constraint that the elements of the slice type satisfy. Type errors are
+// ignored because the methods generated previously may be out of date.
+func getV2Level(fset *token.FileSet, pkg *ast.Package, sliceType string) int {
+	var fileNames []string
+	for fileName := range pkg.Files {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+
+	var files []*ast.File
+	for _, fileName := range fileNames {
+		files = append(files, pkg.Files[fileName])
+	}
+
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error:    func(error) {},
+	}
+	typesPkg, _ := conf.Check(pkg.Name, fset, files, nil)
+
+	switch t := typesPkg.Scope().Lookup(sliceType).Type().Underlying().(type) {
+	case *types.Slice:
+		return getV2ElementLevel(t.Elem())
+
+	case *types.Map:
+		panic("maps are not supported with -v2")
+	}
+
+	panic(fmt.Sprintf("type %s must be a slice or map", sliceType))
+}
+
+// generateV2 returns the source of methods for the slice type that call the
+// generic functions in github.com/elliotchance/pie/v2, instead of copying the
+// v1 templates.
+func generateV2(packageName, sliceType, elementType string, fns []string, level int) string {
+	var names []string
+	for name, tmpl := range pieV2Templates {
+		if fns[0] != "*" && !stringSliceContains(fns, name) {
+			continue
+		}
+
+		if tmpl.Level <= level {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	mapImports := map[string]struct{}{
+		`"github.com/elliotchance/pie/v2"`: {},
+	}
+	for _, name := range names {
+		for _, imp := range pieV2Templates[name].Imports {
+			mapImports[fmt.Sprintf("%q", imp)] = struct{}{}
+		}
+	}
+
+	var imports []string
+	for imp := range mapImports {
+		imports = append(imports, imp)
+	}
+	sort.Strings(imports)
+
+	t := "// Code generated by pie; DO NOT EDIT.\n\n"
+	t += fmt.Sprintf("package %s\n\nimport (", packageName)
+	for _, imp := range imports {
+		t += fmt.Sprintf("\n\t%s", imp)
+	}
+	t += "\n)\n"
+
+	for _, name := range names {
+		t += "\n" + pieV2Templates[name].Code
+	}
+
+	t = strings.Replace(t, "SliceType", sliceType, -1)
+	t = strings.Replace(t, "ElementType", elementType, -1)
+
+	return t
+}
@@ -0,0 +1,34 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var addTests = []struct {
+	ss1, ss2 []int
+	expected []int
+}{
+	{nil, nil, nil},
+	{[]int{1}, []int{2}, []int{3}},
+	{[]int{1, 2, 3}, []int{10, 20, 30}, []int{11, 22, 33}},
+	{[]int{1, 2, 3, 4, 5, 6, 7, 8, 9}, []int{9, 8, 7, 6, 5, 4, 3, 2, -1}, []int{10, 10, 10, 10, 10, 10, 10, 10, 8}},
+}
+
+func TestAdd(t *testing.T) {
+	for _, test := range addTests {
+		t.Run("", func(t *testing.T) {
+			result, err := pie.Add(test.ss1, test.ss2)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestAddUnequalLengths(t *testing.T) {
+	result, err := pie.Add([]int{1, 2}, []int{1})
+	assert.ErrorIs(t, err, pie.ErrUnequalLengths)
+	assert.Nil(t, result)
+}
@@ -0,0 +1,441 @@
+package pie_test
+
+import (
+	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// aliasingTests call each function that takes and returns a slice. The
+// returned slices are modified to make sure they do not share memory with the
+// input. Functions that return a different element type can return nil, they
+// are still checked for modifying the input. The Append functions are given a
+// nil destination, since they are expected to append to it.
+var aliasingTests = map[string]func(ss []int) [][]int{
+	"Add": func(ss []int) [][]int {
+		result, _ := pie.Add(ss, ss)
+		return [][]int{result}
+	},
+	"AppendChunk": func(ss []int) [][]int {
+		return pie.AppendChunk(nil, ss, 2)
+	},
+	"AppendFilter": func(ss []int) [][]int {
+		return [][]int{pie.AppendFilter(nil, ss, func(int) bool { return true })}
+	},
+	"AppendFilterMap": func(ss []int) [][]int {
+		return [][]int{pie.AppendFilterMap(nil, ss, func(s int) (int, bool) { return s, true })}
+	},
+	"AppendFilterNot": func(ss []int) [][]int {
+		return [][]int{pie.AppendFilterNot(nil, ss, func(int) bool { return false })}
+	},
+	"AppendFlat": func(ss []int) [][]int {
+		return [][]int{pie.AppendFlat(nil, [][]int{ss})}
+	},
+	"AppendFlatMap": func(ss []int) [][]int {
+		return [][]int{pie.AppendFlatMap(nil, ss, func(s int) []int { return ss })}
+	},
+	"AppendMap": func(ss []int) [][]int {
+		return [][]int{pie.AppendMap(nil, ss, func(s int) int { return s })}
+	},
+	"AppendUnique": func(ss []int) [][]int {
+		return [][]int{pie.AppendUnique(nil, ss)}
+	},
+	"AppendUniqueSet": func(ss []int) [][]int {
+		return [][]int{pie.AppendUniqueSet(nil, ss, map[int]struct{}{})}
+	},
+	"Bottom": func(ss []int) [][]int {
+		return [][]int{pie.Bottom(ss, 2)}
+	},
+	"Bucketize": func(ss []int) [][]int {
+		return [][]int{pie.Bucketize(ss, []float64{0, 3, 10})}
+	},
+	"Chunk": func(ss []int) [][]int {
+		return pie.Chunk(ss, 2)
+	},
+	"Clamp": func(ss []int) [][]int {
+		return [][]int{pie.Clamp(ss, 2, 4)}
+	},
+	"Combinations": func(ss []int) [][]int {
+		combinations, _ := pie.Combinations(ss, 2, 100)
+		return combinations
+	},
+	"CombinationsWithReplacement": func(ss []int) [][]int {
+		combinations, _ := pie.CombinationsWithReplacement(ss, 2, 100)
+		return combinations
+	},
+	"Compact": func(ss []int) [][]int {
+		return [][]int{pie.Compact(ss)}
+	},
+	"CumulativeDot": func(ss []int) [][]int {
+		result, _ := pie.CumulativeDot(ss, ss)
+		return [][]int{result}
+	},
+	"CumulativeMax": func(ss []int) [][]int {
+		return [][]int{pie.CumulativeMax(ss)}
+	},
+	"CumulativeProduct": func(ss []int) [][]int {
+		return [][]int{pie.CumulativeProduct(ss)}
+	},
+	"CumulativeSum": func(ss []int) [][]int {
+		return [][]int{pie.CumulativeSum(ss)}
+	},
+	"Dedup": func(ss []int) [][]int {
+		return [][]int{pie.Dedup(ss)}
+	},
+	"DedupBy": func(ss []int) [][]int {
+		return [][]int{pie.DedupBy(ss, func(s int) int { return s / 2 })}
+	},
+	"Delete": func(ss []int) [][]int {
+		return [][]int{pie.Delete(ss, 0), pie.Delete(ss, 5), pie.Delete(ss, 2, 0)}
+	},
+	"Diff": func(ss []int) [][]int {
+		added, removed := pie.Diff(ss, []int{2, 9})
+		return [][]int{added, removed}
+	},
+	"Diffs": func(ss []int) [][]int {
+		return [][]int{pie.Diffs(ss)}
+	},
+	"Divide": func(ss []int) [][]int {
+		result, _ := pie.Divide(ss, ss)
+		return [][]int{result}
+	},
+	"DropTop": func(ss []int) [][]int {
+		return [][]int{pie.DropTop(ss, 1)}
+	},
+	"DropWhile": func(ss []int) [][]int {
+		return [][]int{pie.DropWhile(ss, func(s int) bool { return s < 2 })}
+	},
+	"Each": func(ss []int) [][]int {
+		return [][]int{pie.Each(ss, func(int) {})}
+	},
+	"Enumerate": func(ss []int) [][]int {
+		pie.Enumerate(ss)
+		return nil
+	},
+	"ExponentialMovingAverage": func(ss []int) [][]int {
+		pie.ExponentialMovingAverage(ss, 0.5)
+		return nil
+	},
+	"Filter": func(ss []int) [][]int {
+		return [][]int{pie.Filter(ss, func(int) bool { return true })}
+	},
+	"FilterMap": func(ss []int) [][]int {
+		return [][]int{pie.FilterMap(ss, func(s int) (int, bool) { return s, true })}
+	},
+	"FilterNot": func(ss []int) [][]int {
+		return [][]int{pie.FilterNot(ss, func(int) bool { return false })}
+	},
+	"Flat": func(ss []int) [][]int {
+		return [][]int{pie.Flat([][]int{ss}), pie.Flat([][]int{ss, ss})}
+	},
+	"FlatMap": func(ss []int) [][]int {
+		return [][]int{pie.FlatMap(ss, func(s int) []int { return ss })}
+	},
+	"Float64s": func(ss []int) [][]int {
+		pie.Float64s(ss)
+		return nil
+	},
+	"Histogram": func(ss []int) [][]int {
+		pie.Histogram(ss, 2)
+		return nil
+	},
+	"HistogramEdges": func(ss []int) [][]int {
+		pie.HistogramEdges(ss, []float64{0, 3, 10})
+		return nil
+	},
+	"HistogramQuantiles": func(ss []int) [][]int {
+		pie.HistogramQuantiles(ss, 2)
+		return nil
+	},
+	"Insert": func(ss []int) [][]int {
+		return [][]int{pie.Insert(ss, 0, 9), pie.Insert(ss, 1), pie.Insert(ss, len(ss), 9)}
+	},
+	"Intersect": func(ss []int) [][]int {
+		return [][]int{pie.Intersect(ss, ss)}
+	},
+	"Ints": func(ss []int) [][]int {
+		return [][]int{pie.Ints(ss)}
+	},
+	"JSONBytes": func(ss []int) [][]int {
+		pie.JSONBytes(ss)
+		return nil
+	},
+	"JSONBytesIndent": func(ss []int) [][]int {
+		pie.JSONBytesIndent(ss, "", "  ")
+		return nil
+	},
+	"JSONMarshal": func(ss []int) [][]int {
+		pie.JSONMarshal(ss)
+		return nil
+	},
+	"JSONMarshalIndent": func(ss []int) [][]int {
+		pie.JSONMarshalIndent(ss, "", "  ")
+		return nil
+	},
+	"Map": func(ss []int) [][]int {
+		return [][]int{pie.Map(ss, func(s int) int { return s })}
+	},
+	"MapIndexed": func(ss []int) [][]int {
+		return [][]int{pie.MapIndexed(ss, func(_, s int) int { return s })}
+	},
+	"MinMaxScale": func(ss []int) [][]int {
+		pie.MinMaxScale(ss)
+		return nil
+	},
+	"Mode": func(ss []int) [][]int {
+		return [][]int{pie.Mode(ss)}
+	},
+	"Multiply": func(ss []int) [][]int {
+		result, _ := pie.Multiply(ss, ss)
+		return [][]int{result}
+	},
+	// Pipeline.Run is a method, so it is not checked by
+	// TestAliasingTestsHaveAllFunctions.
+	"NewPipeline": func(ss []int) [][]int {
+		p := pie.NewPipeline[int]("aliasing")
+		sorted := pie.Then(p, pie.NewStage("sort", pie.Sort[int]))
+
+		return [][]int{p.Run(ss), sorted.Run(ss)}
+	},
+	"NormalizeL1": func(ss []int) [][]int {
+		pie.NormalizeL1(ss)
+		return nil
+	},
+	"NormalizeL2": func(ss []int) [][]int {
+		pie.NormalizeL2(ss)
+		return nil
+	},
+	"Permutations": func(ss []int) [][]int {
+		permutations, _ := pie.Permutations(ss, 1000)
+		return permutations
+	},
+	"PowerSet": func(ss []int) [][]int {
+		subsets, _ := pie.PowerSet(ss, 100)
+		return subsets
+	},
+	"Reverse": func(ss []int) [][]int {
+		return [][]int{pie.Reverse(ss)}
+	},
+	"RollingMax": func(ss []int) [][]int {
+		return [][]int{pie.RollingMax(ss, 1), pie.RollingMax(ss, 2)}
+	},
+	"RollingMean": func(ss []int) [][]int {
+		pie.RollingMean(ss, 1)
+		return nil
+	},
+	"RollingMedian": func(ss []int) [][]int {
+		return [][]int{pie.RollingMedian(ss, 1), pie.RollingMedian(ss, 2)}
+	},
+	"RollingMin": func(ss []int) [][]int {
+		return [][]int{pie.RollingMin(ss, 1), pie.RollingMin(ss, 2)}
+	},
+	"RollingSum": func(ss []int) [][]int {
+		return [][]int{pie.RollingSum(ss, 1), pie.RollingSum(ss, 2)}
+	},
+	"Rotate": func(ss []int) [][]int {
+		return [][]int{pie.Rotate(ss, 0), pie.Rotate(ss, 1), pie.Rotate(ss, -2)}
+	},
+	"Round": func(ss []int) [][]int {
+		return [][]int{pie.Round(ss, 0), pie.Round(ss, -1)}
+	},
+	"RunLengthDecode": func(ss []int) [][]int {
+		return [][]int{pie.RunLengthDecode(pie.RunLengthEncode(ss))}
+	},
+	"RunLengthEncode": func(ss []int) [][]int {
+		pie.RunLengthEncode(ss)
+		return nil
+	},
+	"RunLengthEncodeBy": func(ss []int) [][]int {
+		pie.RunLengthEncodeBy(ss, func(s int) int { return s / 2 })
+		return nil
+	},
+	"Sample": func(ss []int) [][]int {
+		return [][]int{
+			pie.Sample(ss, 2, rand.NewSource(0)),
+			pie.Sample(ss, 10, rand.NewSource(0)),
+		}
+	},
+	"SampleWithReplacement": func(ss []int) [][]int {
+		return [][]int{pie.SampleWithReplacement(ss, 2, rand.NewSource(0))}
+	},
+	"Scale": func(ss []int) [][]int {
+		return [][]int{pie.Scale(ss, 2)}
+	},
+	"Scan": func(ss []int) [][]int {
+		return [][]int{pie.Scan(ss, 0, func(a, s int) int { return a + s })}
+	},
+	"Send": func(ss []int) [][]int {
+		return [][]int{pie.Send(context.Background(), ss, make(chan int, len(ss)))}
+	},
+	"Sequence": func(ss []int) [][]int {
+		return [][]int{pie.Sequence(ss, 3)}
+	},
+	"SequenceUsing": func(ss []int) [][]int {
+		return [][]int{pie.SequenceUsing(ss, func(i int) int { return i }, 3)}
+	},
+	"Shift": func(ss []int) [][]int {
+		_, rest := pie.Shift(ss)
+		return [][]int{rest}
+	},
+	"Shuffle": func(ss []int) [][]int {
+		return [][]int{pie.Shuffle(ss, rand.NewSource(0))}
+	},
+	"ShuffleSecure": func(ss []int) [][]int {
+		shuffled, _ := pie.ShuffleSecure(ss)
+		return [][]int{shuffled}
+	},
+	"ShuffleSeed": func(ss []int) [][]int {
+		return [][]int{pie.ShuffleSeed(ss, 0)}
+	},
+	"ShuffleWith": func(ss []int) [][]int {
+		return [][]int{pie.ShuffleWith(ss, rand.NewSource(0).(rand.Source64))}
+	},
+	"Sort": func(ss []int) [][]int {
+		return [][]int{pie.Sort(ss)}
+	},
+	"SortStableUsing": func(ss []int) [][]int {
+		return [][]int{pie.SortStableUsing(ss, func(a, b int) bool { return a > b })}
+	},
+	"SortUsing": func(ss []int) [][]int {
+		return [][]int{pie.SortUsing(ss, func(a, b int) bool { return a > b })}
+	},
+	"Strings": func(ss []int) [][]int {
+		pie.Strings(ss)
+		return nil
+	},
+	"StringsUsing": func(ss []int) [][]int {
+		pie.StringsUsing(ss, func(int) string { return "" })
+		return nil
+	},
+	"SubSlice": func(ss []int) [][]int {
+		return [][]int{pie.SubSlice(ss, 0, 2), pie.SubSlice(ss, 1, 10)}
+	},
+	"Subtract": func(ss []int) [][]int {
+		result, _ := pie.Subtract(ss, ss)
+		return [][]int{result}
+	},
+	"Top": func(ss []int) [][]int {
+		return [][]int{pie.Top(ss, 2)}
+	},
+	"Unique": func(ss []int) [][]int {
+		return [][]int{pie.Unique(ss)}
+	},
+	"UniqueStable": func(ss []int) [][]int {
+		return [][]int{pie.UniqueStable(ss)}
+	},
+	"Unzip": func(ss []int) [][]int {
+		ss1, ss2 := pie.Unzip(pie.Zip(ss, ss))
+		return [][]int{ss1, ss2}
+	},
+	"Unshift": func(ss []int) [][]int {
+		return [][]int{pie.Unshift(ss), pie.Unshift(ss, 9)}
+	},
+	"WeightedSample": func(ss []int) [][]int {
+		weight := func(s int) float64 { return float64(s) }
+		return [][]int{
+			pie.WeightedSample(ss, 2, weight, rand.NewSource(0)),
+			pie.WeightedSample(ss, 10, weight, rand.NewSource(0)),
+		}
+	},
+	"Zip": func(ss []int) [][]int {
+		pie.Zip(ss, ss)
+		return nil
+	},
+	"Zip3": func(ss []int) [][]int {
+		pie.Zip3(ss, ss, ss)
+		return nil
+	},
+	"ZipLongest": func(ss []int) [][]int {
+		pie.ZipLongest(ss, ss)
+		return nil
+	},
+	"ZipLongestWith": func(ss []int) [][]int {
+		pie.ZipLongestWith(ss, ss, 0, 0)
+		return nil
+	},
+	"ZipN": func(ss []int) [][]int {
+		return pie.ZipN(ss, ss)
+	},
+	"ZipStrict": func(ss []int) [][]int {
+		pie.ZipStrict(ss, ss)
+		return nil
+	},
+	"ZScore": func(ss []int) [][]int {
+		pie.ZScore(ss)
+		return nil
+	},
+	"ZipWith": func(ss []int) [][]int {
+		return [][]int{pie.ZipWith(ss, ss, func(a, b int) int { return a + b })}
+	},
+}
+
+func TestAliasing(t *testing.T) {
+	for name, fn := range aliasingTests {
+		fn := fn
+		t.Run(name, func(t *testing.T) {
+			for n := 0; n <= 5; n++ {
+				// The spare capacity after ss catches results that share the
+				// backing array and are appended to.
+				backing := []int{1, 2, 3, 4, 5, 6, 7, 8}
+				expected := append([]int(nil), backing...)
+
+				results := fn(backing[:n])
+				require.Equal(t, expected, backing, "input was modified")
+
+				for _, result := range results {
+					for i := range result {
+						result[i] = -1
+					}
+					_ = append(result, -1, -1, -1)
+				}
+				assert.Equal(t, expected, backing, "result shares memory with the input")
+			}
+		})
+	}
+}
+
+// TestAliasingTestsHaveAllFunctions makes sure that every function that takes
+// and returns a slice is in aliasingTests.
+func TestAliasingTestsHaveAllFunctions(t *testing.T) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	require.NoError(t, err)
+
+	isSlice := func(fields *ast.FieldList) bool {
+		if fields == nil {
+			return false
+		}
+
+		for _, field := range fields.List {
+			if array, ok := field.Type.(*ast.ArrayType); ok && array.Len == nil {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, file := range pkgs["pie"].Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv != nil || !fn.Name.IsExported() {
+				continue
+			}
+
+			if isSlice(fn.Type.Params) && isSlice(fn.Type.Results) {
+				assert.Contains(t, aliasingTests, fn.Name.Name)
+			}
+		}
+	}
+}
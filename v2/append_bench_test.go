@@ -0,0 +1,150 @@
+package pie_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+)
+
+// The Append benchmarks should report 0 allocs/op because the destination is
+// reused between iterations. The only exception is BenchmarkAppendUniqueLarge,
+// which allocates a map on each iteration. BenchmarkAppendUniqueSet shows how
+// to avoid that.
+
+var benchmarkInts = pie.Sequence([]int{}, -500, 500)
+
+// Prevent the compiler from optimizing away the results.
+var (
+	sinkInts   []int
+	sinkChunks [][]int
+)
+
+func negate(i int) int {
+	return -i
+}
+
+func BenchmarkFilter(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sinkInts = pie.Filter(benchmarkInts, isPositive)
+	}
+}
+
+func BenchmarkAppendFilter(b *testing.B) {
+	b.ReportAllocs()
+	var dst []int
+	for i := 0; i < b.N; i++ {
+		dst = pie.AppendFilter(dst[:0], benchmarkInts, isPositive)
+	}
+	sinkInts = dst
+}
+
+func BenchmarkAppendFilterPool(b *testing.B) {
+	pool := sync.Pool{
+		New: func() interface{} {
+			return new([]int)
+		},
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dst := pool.Get().(*[]int)
+		*dst = pie.AppendFilter((*dst)[:0], benchmarkInts, isPositive)
+		pool.Put(dst)
+	}
+}
+
+func BenchmarkMap(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sinkInts = pie.Map(benchmarkInts, negate)
+	}
+}
+
+func BenchmarkAppendMap(b *testing.B) {
+	b.ReportAllocs()
+	var dst []int
+	for i := 0; i < b.N; i++ {
+		dst = pie.AppendMap(dst[:0], benchmarkInts, negate)
+	}
+	sinkInts = dst
+}
+
+func BenchmarkFlat(b *testing.B) {
+	ss := pie.Chunk(benchmarkInts, 10)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sinkInts = pie.Flat(ss)
+	}
+}
+
+func BenchmarkAppendFlat(b *testing.B) {
+	ss := pie.Chunk(benchmarkInts, 10)
+	b.ReportAllocs()
+	var dst []int
+	for i := 0; i < b.N; i++ {
+		dst = pie.AppendFlat(dst[:0], ss)
+	}
+	sinkInts = dst
+}
+
+func BenchmarkUniqueSmall(b *testing.B) {
+	ss := benchmarkInts[:20]
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sinkInts = pie.Unique(ss)
+	}
+}
+
+func BenchmarkAppendUniqueSmall(b *testing.B) {
+	ss := benchmarkInts[:20]
+	b.ReportAllocs()
+	var dst []int
+	for i := 0; i < b.N; i++ {
+		dst = pie.AppendUnique(dst[:0], ss)
+	}
+	sinkInts = dst
+}
+
+func BenchmarkUniqueLarge(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sinkInts = pie.Unique(benchmarkInts)
+	}
+}
+
+func BenchmarkAppendUniqueLarge(b *testing.B) {
+	b.ReportAllocs()
+	var dst []int
+	for i := 0; i < b.N; i++ {
+		dst = pie.AppendUnique(dst[:0], benchmarkInts)
+	}
+	sinkInts = dst
+}
+
+func BenchmarkAppendUniqueSet(b *testing.B) {
+	b.ReportAllocs()
+	var dst []int
+	seen := map[int]struct{}{}
+	for i := 0; i < b.N; i++ {
+		dst = pie.AppendUniqueSet(dst[:0], benchmarkInts, seen)
+	}
+	sinkInts = dst
+}
+
+func BenchmarkChunk(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sinkChunks = pie.Chunk(benchmarkInts, 10)
+	}
+}
+
+func BenchmarkAppendChunk(b *testing.B) {
+	b.ReportAllocs()
+	var dst [][]int
+	for i := 0; i < b.N; i++ {
+		dst = pie.AppendChunk(dst[:0], benchmarkInts, 10)
+	}
+	sinkChunks = dst
+}
@@ -0,0 +1,34 @@
+package pie
+
+// AppendChunk appends the chunks of ss to dst and returns the extended slice.
+// It is the same as Chunk, except dst can be reused to avoid allocations. See
+// AppendFilter.
+//
+// The chunks are copied from ss into the slices left in the spare capacity of
+// dst by a previous call, so in steady state nothing is allocated:
+//
+//	chunks = pie.AppendChunk(chunks[:0], ss, 100)
+//
+// This means the chunks returned by the previous call are overwritten, in the
+// same way as dst itself.
+func AppendChunk[T any](dst [][]T, ss []T, chunkLength int) [][]T {
+	if chunkLength <= 0 {
+		panic("chunkLength should be greater than 0")
+	}
+
+	for start := 0; start < len(ss); start += chunkLength {
+		end := start + chunkLength
+		if end > len(ss) {
+			end = len(ss)
+		}
+
+		var chunk []T
+		if n := len(dst); n < cap(dst) {
+			chunk = dst[: n+1 : n+1][n][:0]
+		}
+
+		dst = append(dst, append(chunk, ss[start:end]...))
+	}
+
+	return dst
+}
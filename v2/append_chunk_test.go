@@ -0,0 +1,45 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var appendChunkTests = []struct {
+	dst         [][]int
+	ss          []int
+	chunkLength int
+	expected    [][]int
+}{
+	{nil, nil, 2, nil},
+	{nil, []int{1, 2, 3}, 4, [][]int{{1, 2, 3}}},
+	{nil, []int{1, 2, 3}, 3, [][]int{{1, 2, 3}}},
+	{nil, []int{1, 2, 3}, 2, [][]int{{1, 2}, {3}}},
+	{[][]int{{0}}, []int{1, 2, 3}, 1, [][]int{{0}, {1}, {2}, {3}}},
+}
+
+func TestAppendChunk(t *testing.T) {
+	for _, test := range appendChunkTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.AppendChunk(test.dst, test.ss, test.chunkLength))
+		})
+	}
+}
+
+func TestAppendChunkPanics(t *testing.T) {
+	assert.PanicsWithValue(t, "chunkLength should be greater than 0", func() {
+		pie.AppendChunk(nil, []int{1}, 0)
+	})
+}
+
+func TestAppendChunkReusesDst(t *testing.T) {
+	ss := []int{1, 2, 3, 4, 5}
+	dst := pie.AppendChunk(nil, ss, 2)
+
+	assert.Zero(t, testing.AllocsPerRun(100, func() {
+		dst = pie.AppendChunk(dst[:0], ss, 2)
+	}))
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, dst)
+}
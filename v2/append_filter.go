@@ -0,0 +1,16 @@
+package pie
+
+// AppendFilter appends the elements of ss where condition returns true to dst
+// and returns the extended slice. It is the same as Filter, except dst can be
+// reused (in the same way as strconv.AppendInt) to avoid an allocation:
+//
+//	buf = pie.AppendFilter(buf[:0], users, isActive)
+func AppendFilter[T any](dst, ss []T, condition func(T) bool) []T {
+	for _, s := range ss {
+		if condition(s) {
+			dst = append(dst, s)
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,14 @@
+package pie
+
+// AppendFilterMap appends the results of fn to dst, for each element of ss
+// where fn returns true. It is the same as FilterMap, except dst can be reused
+// to avoid an allocation. See AppendFilter.
+func AppendFilterMap[T any, U any](dst []U, ss []T, fn func(T) (U, bool)) []U {
+	for _, s := range ss {
+		if u, ok := fn(s); ok {
+			dst = append(dst, u)
+		}
+	}
+
+	return dst
+}
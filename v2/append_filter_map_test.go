@@ -0,0 +1,33 @@
+package pie_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppendFilterMap(t *testing.T) {
+	parse := func(s string) (int, bool) {
+		i, err := strconv.Atoi(s)
+		return i, err == nil
+	}
+
+	assert.Equal(t, []int(nil), pie.AppendFilterMap(nil, []string(nil), parse))
+	assert.Equal(t, []int{1, 3}, pie.AppendFilterMap(nil, []string{"1", "a", "3"}, parse))
+	assert.Equal(t, []int{0, 3}, pie.AppendFilterMap([]int{0}, []string{"b", "3"}, parse))
+}
+
+func TestAppendFilterMapReusesDst(t *testing.T) {
+	ss := []int{1, -2, 3}
+	dst := make([]int, 0, len(ss))
+	negatePositive := func(i int) (int, bool) {
+		return -i, i > 0
+	}
+
+	assert.Zero(t, testing.AllocsPerRun(100, func() {
+		dst = pie.AppendFilterMap(dst[:0], ss, negatePositive)
+	}))
+	assert.Equal(t, []int{-1, -3}, dst)
+}
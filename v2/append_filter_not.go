@@ -0,0 +1,14 @@
+package pie
+
+// AppendFilterNot appends the elements of ss where condition returns false to
+// dst and returns the extended slice. It is the same as FilterNot, except dst
+// can be reused to avoid an allocation. See AppendFilter.
+func AppendFilterNot[T any](dst, ss []T, condition func(T) bool) []T {
+	for _, s := range ss {
+		if !condition(s) {
+			dst = append(dst, s)
+		}
+	}
+
+	return dst
+}
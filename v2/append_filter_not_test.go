@@ -0,0 +1,36 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var appendFilterNotTests = []struct {
+	dst, ss  []int
+	expected []int
+}{
+	{nil, nil, nil},
+	{nil, []int{1, 2}, nil},
+	{nil, []int{1, -2, 3}, []int{-2}},
+	{[]int{7}, []int{1, -2, 0}, []int{7, -2, 0}},
+}
+
+func TestAppendFilterNot(t *testing.T) {
+	for _, test := range appendFilterNotTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.AppendFilterNot(test.dst, test.ss, isPositive))
+		})
+	}
+}
+
+func TestAppendFilterNotReusesDst(t *testing.T) {
+	ss := []int{1, -2, 3, -4, 5}
+	dst := make([]int, 0, len(ss))
+
+	assert.Zero(t, testing.AllocsPerRun(100, func() {
+		dst = pie.AppendFilterNot(dst[:0], ss, isPositive)
+	}))
+	assert.Equal(t, []int{-2, -4}, dst)
+}
@@ -0,0 +1,41 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func isPositive(i int) bool {
+	return i > 0
+}
+
+var appendFilterTests = []struct {
+	dst, ss  []int
+	expected []int
+}{
+	{nil, nil, nil},
+	{nil, []int{-1, 0}, nil},
+	{nil, []int{1, -2, 3}, []int{1, 3}},
+	{[]int{7}, []int{1, -2, 3}, []int{7, 1, 3}},
+	{[]int{-7}, []int{-1}, []int{-7}},
+}
+
+func TestAppendFilter(t *testing.T) {
+	for _, test := range appendFilterTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.AppendFilter(test.dst, test.ss, isPositive))
+		})
+	}
+}
+
+func TestAppendFilterReusesDst(t *testing.T) {
+	ss := []int{1, -2, 3, -4, 5}
+	dst := make([]int, 0, len(ss))
+
+	assert.Zero(t, testing.AllocsPerRun(100, func() {
+		dst = pie.AppendFilter(dst[:0], ss, isPositive)
+	}))
+	assert.Equal(t, []int{1, 3, 5}, dst)
+}
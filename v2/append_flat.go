@@ -0,0 +1,12 @@
+package pie
+
+// AppendFlat appends the elements of each slice in ss to dst and returns the
+// extended slice. It is the same as Flat, except dst can be reused to avoid an
+// allocation. See AppendFilter.
+func AppendFlat[T any](dst []T, ss [][]T) []T {
+	for _, s := range ss {
+		dst = append(dst, s...)
+	}
+
+	return dst
+}
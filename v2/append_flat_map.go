@@ -0,0 +1,12 @@
+package pie
+
+// AppendFlatMap appends the elements returned by fn for each element of ss to
+// dst and returns the extended slice. It is the same as FlatMap, except dst can
+// be reused to avoid an allocation. See AppendFilter.
+func AppendFlatMap[T any, U any](dst []U, ss []T, fn func(T) []U) []U {
+	for _, s := range ss {
+		dst = append(dst, fn(s)...)
+	}
+
+	return dst
+}
@@ -0,0 +1,30 @@
+package pie_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppendFlatMap(t *testing.T) {
+	assert.Equal(t, []string(nil), pie.AppendFlatMap(nil, []string(nil), strings.Fields))
+	assert.Equal(t, []string{"a", "b", "c"},
+		pie.AppendFlatMap(nil, []string{"a b", "", "c"}, strings.Fields))
+	assert.Equal(t, []string{"z", "a", "b"},
+		pie.AppendFlatMap([]string{"z"}, []string{"a b"}, strings.Fields))
+}
+
+func TestAppendFlatMapReusesDst(t *testing.T) {
+	ss := [][]int{{1, 2}, {3}}
+	dst := make([]int, 0, 3)
+	identity := func(s []int) []int {
+		return s
+	}
+
+	assert.Zero(t, testing.AllocsPerRun(100, func() {
+		dst = pie.AppendFlatMap(dst[:0], ss, identity)
+	}))
+	assert.Equal(t, []int{1, 2, 3}, dst)
+}
@@ -0,0 +1,37 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var appendFlatTests = []struct {
+	dst      []int
+	ss       [][]int
+	expected []int
+}{
+	{nil, nil, nil},
+	{nil, [][]int{nil, {}}, nil},
+	{nil, [][]int{{1, 2}, {3}}, []int{1, 2, 3}},
+	{[]int{0}, [][]int{{1}, nil, {2, 3}}, []int{0, 1, 2, 3}},
+}
+
+func TestAppendFlat(t *testing.T) {
+	for _, test := range appendFlatTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.AppendFlat(test.dst, test.ss))
+		})
+	}
+}
+
+func TestAppendFlatReusesDst(t *testing.T) {
+	ss := [][]int{{1, 2}, {3}, {4, 5}}
+	dst := make([]int, 0, 5)
+
+	assert.Zero(t, testing.AllocsPerRun(100, func() {
+		dst = pie.AppendFlat(dst[:0], ss)
+	}))
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, dst)
+}
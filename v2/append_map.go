@@ -0,0 +1,15 @@
+package pie
+
+import "golang.org/x/exp/slices"
+
+// AppendMap appends the result of fn for each element of ss to dst and returns
+// the extended slice. It is the same as Map, except dst can be reused to avoid
+// an allocation. See AppendFilter.
+func AppendMap[T any, U any](dst []U, ss []T, fn func(T) U) []U {
+	dst = slices.Grow(dst, len(ss))
+	for _, s := range ss {
+		dst = append(dst, fn(s))
+	}
+
+	return dst
+}
@@ -0,0 +1,29 @@
+package pie_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppendMap(t *testing.T) {
+	assert.Equal(t, []string(nil), pie.AppendMap(nil, []int(nil), strconv.Itoa))
+	assert.Equal(t, []string{"1", "2"}, pie.AppendMap(nil, []int{1, 2}, strconv.Itoa))
+	assert.Equal(t, []string{"0", "1", "2"},
+		pie.AppendMap([]string{"0"}, []int{1, 2}, strconv.Itoa))
+}
+
+func TestAppendMapReusesDst(t *testing.T) {
+	ss := []int{1, 2, 3}
+	dst := make([]int, 0, len(ss))
+	double := func(i int) int {
+		return i * 2
+	}
+
+	assert.Zero(t, testing.AllocsPerRun(100, func() {
+		dst = pie.AppendMap(dst[:0], ss, double)
+	}))
+	assert.Equal(t, []int{2, 4, 6}, dst)
+}
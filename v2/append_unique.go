@@ -0,0 +1,36 @@
+package pie
+
+// appendUniqueSearchLimit is the most elements that AppendUnique will search
+// without a map. The search is quadratic, but it avoids allocating the map.
+const appendUniqueSearchLimit = 32
+
+// AppendUnique appends the unique elements of ss to dst and returns the
+// extended slice. Elements that were already in dst are not considered.
+//
+// Unlike Unique, the elements are appended in the order they first appear in
+// ss. AppendUnique only allocates (apart from growing dst) when ss has more
+// than 32 elements, for the map of elements it has seen. Use AppendUniqueSet
+// to reuse that map instead. See AppendFilter.
+func AppendUnique[T comparable](dst, ss []T) []T {
+	start := len(dst)
+
+	if len(ss) <= appendUniqueSearchLimit {
+		for _, s := range ss {
+			if !Contains(dst[start:], s) {
+				dst = append(dst, s)
+			}
+		}
+
+		return dst
+	}
+
+	seen := make(map[T]struct{}, len(ss))
+	for _, s := range ss {
+		if _, ok := seen[s]; !ok {
+			seen[s] = struct{}{}
+			dst = append(dst, s)
+		}
+	}
+
+	return dst
+}
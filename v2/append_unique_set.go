@@ -0,0 +1,27 @@
+package pie
+
+// AppendUniqueSet is the same as AppendUnique, except seen is used to find the
+// elements that have already been appended. seen is cleared first, so the same
+// map can be reused to avoid allocating one for each call:
+//
+//	seen := map[string]struct{}{}
+//	for _, names := range batches {
+//	    buf = pie.AppendUniqueSet(buf[:0], names, seen)
+//	    ...
+//	}
+//
+// seen must not be nil.
+func AppendUniqueSet[T comparable](dst, ss []T, seen map[T]struct{}) []T {
+	for s := range seen {
+		delete(seen, s)
+	}
+
+	for _, s := range ss {
+		if _, ok := seen[s]; !ok {
+			seen[s] = struct{}{}
+			dst = append(dst, s)
+		}
+	}
+
+	return dst
+}
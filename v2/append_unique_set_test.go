@@ -0,0 +1,28 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppendUniqueSet(t *testing.T) {
+	for _, test := range appendUniqueTests {
+		t.Run("", func(t *testing.T) {
+			seen := map[int]struct{}{-1: {}, 2: {}}
+			assert.Equal(t, test.expected, pie.AppendUniqueSet(test.dst, test.ss, seen))
+		})
+	}
+}
+
+func TestAppendUniqueSetReusesSeen(t *testing.T) {
+	ss := pie.Sequence([]int{}, 100)
+	dst := make([]int, 0, len(ss))
+	seen := map[int]struct{}{}
+
+	assert.Zero(t, testing.AllocsPerRun(100, func() {
+		dst = pie.AppendUniqueSet(dst[:0], ss, seen)
+	}))
+	assert.Equal(t, ss, dst)
+}
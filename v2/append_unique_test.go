@@ -0,0 +1,41 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var appendUniqueTests = []struct {
+	dst, ss  []int
+	expected []int
+}{
+	{nil, nil, nil},
+	{nil, []int{3, 1, 3, 2, 1}, []int{3, 1, 2}},
+	{[]int{1}, []int{1, 2, 1}, []int{1, 1, 2}},
+	{nil, pie.Sequence([]int{}, 100), pie.Sequence([]int{}, 100)},
+	{
+		[]int{-1},
+		append(pie.Sequence([]int{}, 50), pie.Sequence([]int{}, 50)...),
+		append([]int{-1}, pie.Sequence([]int{}, 50)...),
+	},
+}
+
+func TestAppendUnique(t *testing.T) {
+	for _, test := range appendUniqueTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.AppendUnique(test.dst, test.ss))
+		})
+	}
+}
+
+func TestAppendUniqueReusesDst(t *testing.T) {
+	ss := []string{"a", "b", "a", "c", "b"}
+	dst := make([]string, 0, len(ss))
+
+	assert.Zero(t, testing.AllocsPerRun(100, func() {
+		dst = pie.AppendUnique(dst[:0], ss)
+	}))
+	assert.Equal(t, []string{"a", "b", "c"}, dst)
+}
@@ -0,0 +1,53 @@
+package pie
+
+import (
+	"math"
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Bucketize returns the index of the bucket for each element, where bucket i
+// is between edges[i] and edges[i+1]. Each bucket includes the lower edge but
+// not the upper edge, except for the last bucket, which includes both:
+//
+//	Bucketize([0, 4.5, 5, 10, 11], [0, 5, 10]) => [0, 0, 1, 1, -1]
+//
+// -1 is used for elements outside of the edges and NaN. It will panic if there
+// are fewer than two edges or the edges are not sorted.
+func Bucketize[T constraints.Integer | constraints.Float](ss []T, edges []float64) []int {
+	checkEdges(edges)
+
+	last := len(edges) - 1
+	buckets := make([]int, len(ss))
+	for i, s := range ss {
+		value := float64(s)
+
+		switch {
+		case math.IsNaN(value) || value < edges[0] || value > edges[last]:
+			buckets[i] = -1
+
+		case value == edges[last]:
+			buckets[i] = last - 1
+
+		default:
+			// The first edge that is greater than value is the end of the
+			// bucket.
+			buckets[i] = sort.Search(len(edges), func(j int) bool {
+				return edges[j] > value
+			}) - 1
+		}
+	}
+
+	return buckets
+}
+
+func checkEdges(edges []float64) {
+	if len(edges) < 2 {
+		panic("edges should have at least 2 values")
+	}
+
+	if !sort.Float64sAreSorted(edges) {
+		panic("edges should be sorted")
+	}
+}
@@ -0,0 +1,38 @@
+package pie_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var bucketizeTests = []struct {
+	ss       []float64
+	edges    []float64
+	expected []int
+}{
+	{nil, []float64{0, 1}, []int{}},
+	{[]float64{0, 4.5, 5, 10, 11}, []float64{0, 5, 10}, []int{0, 0, 1, 1, -1}},
+	{[]float64{-1, math.NaN(), math.Inf(1)}, []float64{0, 5}, []int{-1, -1, -1}},
+	{[]float64{1, 2, 3}, []float64{1, 2, 2, 3, 3}, []int{0, 2, 3}},
+}
+
+func TestBucketize(t *testing.T) {
+	for _, test := range bucketizeTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.Bucketize(test.ss, test.edges))
+		})
+	}
+}
+
+func TestBucketizePanics(t *testing.T) {
+	assert.PanicsWithValue(t, "edges should have at least 2 values", func() {
+		pie.Bucketize([]int{1}, []float64{1})
+	})
+
+	assert.PanicsWithValue(t, "edges should be sorted", func() {
+		pie.Bucketize([]int{1}, []float64{1, 0})
+	})
+}
@@ -0,0 +1,22 @@
+package pie
+
+// CartesianProduct returns every way to choose one element from each of the
+// slices. See CartesianProductSeq for the order.
+//
+// ErrTooManyResults is returned (without generating any products) if there
+// would be more than max.
+func CartesianProduct[T any](max int, sss ...[]T) ([][]T, error) {
+	count, ok := uint64(1), true
+	for _, ss := range sss {
+		count, ok = multiply(count, uint64(len(ss)))
+		if !ok {
+			break
+		}
+	}
+
+	if err := checkResults(count, ok, max); err != nil {
+		return nil, err
+	}
+
+	return collect(CartesianProductSeq(sss...), count), nil
+}
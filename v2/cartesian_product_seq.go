@@ -0,0 +1,53 @@
+package pie
+
+import "golang.org/x/exp/slices"
+
+// CartesianProductSeq returns a sequence (that can be used as an iter.Seq) of
+// every way to choose one element from each of the slices. The last slice
+// changes the fastest:
+//
+//	CartesianProductSeq([a, b], [1, 2]) => [a, 1], [a, 2], [b, 1], [b, 2]
+//
+// Nothing is produced if any of the slices are empty. A single empty product
+// is produced if there are no slices. They are generated lazily and each one
+// is a new slice.
+func CartesianProductSeq[T any](sss ...[]T) func(yield func([]T) bool) {
+	sss = slices.Clone(sss)
+	for i := range sss {
+		sss[i] = slices.Clone(sss[i])
+	}
+
+	return func(yield func([]T) bool) {
+		for _, ss := range sss {
+			if len(ss) == 0 {
+				return
+			}
+		}
+
+		indexes := make([]int, len(sss))
+		for {
+			product := make([]T, len(sss))
+			for i, ss := range sss {
+				product[i] = ss[indexes[i]]
+			}
+
+			if !yield(product) {
+				return
+			}
+
+			i := len(sss) - 1
+			for ; i >= 0; i-- {
+				indexes[i]++
+				if indexes[i] < len(sss[i]) {
+					break
+				}
+
+				indexes[i] = 0
+			}
+
+			if i < 0 {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCartesianProductSeq(t *testing.T) {
+	ss := pie.Sequence([]int{}, 1000)
+	assert.Equal(t, [][]int{{0, 0, 0}, {0, 0, 1}},
+		take(pie.CartesianProductSeq(ss, ss, ss), 2))
+}
+
+func TestCartesianProductSeqCopiesInput(t *testing.T) {
+	ss := []int{1, 2}
+	seq := pie.CartesianProductSeq(ss)
+	ss[0] = 9
+
+	assert.Equal(t, [][]int{{1}, {2}}, take(seq, 10))
+}
@@ -0,0 +1,44 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var cartesianProductTests = []struct {
+	sss      [][]string
+	expected [][]string
+}{
+	{nil, [][]string{{}}},
+	{[][]string{{"a", "b"}, {}}, nil},
+	{[][]string{{"a", "b"}}, [][]string{{"a"}, {"b"}}},
+	{
+		[][]string{{"a", "b"}, {"1", "2"}},
+		[][]string{{"a", "1"}, {"a", "2"}, {"b", "1"}, {"b", "2"}},
+	},
+	{
+		[][]string{{"a"}, {"1", "2"}, {"x", "y"}},
+		[][]string{{"a", "1", "x"}, {"a", "1", "y"}, {"a", "2", "x"}, {"a", "2", "y"}},
+	},
+}
+
+func TestCartesianProduct(t *testing.T) {
+	for _, test := range cartesianProductTests {
+		t.Run("", func(t *testing.T) {
+			products, err := pie.CartesianProduct(100, test.sss...)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, products)
+		})
+	}
+}
+
+func TestCartesianProductTooMany(t *testing.T) {
+	_, err := pie.CartesianProduct(5, []int{1, 2}, []int{1, 2, 3})
+	assert.EqualError(t, err, "too many results: 6 is more than 5")
+
+	ss := make([]int, 1<<16)
+	_, err = pie.CartesianProduct(1000, ss, ss, ss, ss, ss)
+	assert.EqualError(t, err, "too many results: more than 1000")
+}
@@ -1,7 +1,10 @@
 package pie
 
+import "golang.org/x/exp/slices"
+
 // Chunk splits the input and returns multi slices whose length equals chunkLength,
-// except for the last slice which may contain fewer elements.
+// except for the last slice which may contain fewer elements. The chunks are
+// copied from the input, so appending to one chunk will not change the next.
 //
 // Examples:
 //
@@ -22,6 +25,7 @@ func Chunk[T any](ss []T, chunkLength int) [][]T {
 		return result
 	}
 
+	ss = slices.Clone(ss)
 	var step = l / chunkLength
 	if step == 0 {
 		result = append(result, ss)
@@ -29,7 +33,7 @@ func Chunk[T any](ss []T, chunkLength int) [][]T {
 	}
 	var remain = l % chunkLength
 	for i := 0; i < step; i++ {
-		result = append(result, ss[i*chunkLength:(i+1)*chunkLength])
+		result = append(result, ss[i*chunkLength:(i+1)*chunkLength:(i+1)*chunkLength])
 	}
 	if remain != 0 {
 		result = append(result, ss[step*chunkLength:l])
@@ -0,0 +1,36 @@
+package pie
+
+import "golang.org/x/exp/constraints"
+
+// Clamp returns a new slice where elements less than lo are replaced with lo
+// and elements greater than hi are replaced with hi:
+//
+//	Clamp([-5, 2, 12], 0, 10) => [0, 2, 10]
+//
+// nil is returned if there are no elements in the slice. It will panic if lo is
+// greater than hi.
+func Clamp[T constraints.Integer | constraints.Float](ss []T, lo, hi T) []T {
+	if lo > hi {
+		panic("lo should not be greater than hi")
+	}
+
+	if len(ss) == 0 {
+		return nil
+	}
+
+	result := make([]T, len(ss))
+	for i, s := range ss {
+		switch {
+		case s < lo:
+			result[i] = lo
+
+		case s > hi:
+			result[i] = hi
+
+		default:
+			result[i] = s
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,33 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var clampTests = []struct {
+	ss       []float64
+	lo, hi   float64
+	expected []float64
+}{
+	{nil, 0, 1, nil},
+	{[]float64{-5, 2, 12}, 0, 10, []float64{0, 2, 10}},
+	{[]float64{-5, 2, 12}, 3, 3, []float64{3, 3, 3}},
+	{[]float64{0.5, 1.5}, 0, 1, []float64{0.5, 1}},
+}
+
+func TestClamp(t *testing.T) {
+	for _, test := range clampTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.Clamp(test.ss, test.lo, test.hi))
+		})
+	}
+}
+
+func TestClampPanics(t *testing.T) {
+	assert.PanicsWithValue(t, "lo should not be greater than hi", func() {
+		pie.Clamp([]int{1}, 2, 1)
+	})
+}
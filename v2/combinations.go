@@ -0,0 +1,15 @@
+package pie
+
+// Combinations returns every way to choose k elements from ss, where the order
+// does not matter. See CombinationsSeq for the order.
+//
+// ErrTooManyResults is returned (without generating any combinations) if there
+// would be more than max.
+func Combinations[T any](ss []T, k int, max int) ([][]T, error) {
+	count, ok := binomial(len(ss), k)
+	if err := checkResults(count, ok, max); err != nil {
+		return nil, err
+	}
+
+	return collect(CombinationsSeq(ss, k), count), nil
+}
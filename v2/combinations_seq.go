@@ -0,0 +1,47 @@
+package pie
+
+import "golang.org/x/exp/slices"
+
+// CombinationsSeq returns a sequence (that can be used as an iter.Seq) of
+// every way to choose k elements from ss, where the order does not matter.
+// Elements are treated as different based on their position, not their value.
+//
+// The chosen elements keep their order from ss, and the combinations are
+// produced in lexicographic order of the positions:
+//
+//	CombinationsSeq([a, b, c], 2) => [a, b], [a, c], [b, c]
+//
+// Nothing is produced if k is negative or greater than the length of ss. They
+// are generated lazily and each one is a new slice.
+func CombinationsSeq[T any](ss []T, k int) func(yield func([]T) bool) {
+	ss = slices.Clone(ss)
+
+	return func(yield func([]T) bool) {
+		n := len(ss)
+		if k < 0 || k > n {
+			return
+		}
+
+		indexes := make([]int, k)
+		for i := range indexes {
+			indexes[i] = i
+		}
+
+		for yield(pick(ss, indexes)) {
+			// Find the rightmost index that can still be moved right.
+			i := k - 1
+			for i >= 0 && indexes[i] == i+n-k {
+				i--
+			}
+
+			if i < 0 {
+				return
+			}
+
+			indexes[i]++
+			for j := i + 1; j < k; j++ {
+				indexes[j] = indexes[j-1] + 1
+			}
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCombinationsSeq(t *testing.T) {
+	ss := pie.Sequence([]int{}, 1000)
+	assert.Equal(t, [][]int{
+		pie.Sequence([]int{}, 500),
+		append(pie.Sequence([]int{}, 499), 500),
+	}, take(pie.CombinationsSeq(ss, 500), 2))
+}
@@ -0,0 +1,52 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var combinationsTests = []struct {
+	ss       []string
+	k        int
+	expected [][]string
+}{
+	{nil, 0, [][]string{{}}},
+	{nil, 1, nil},
+	{[]string{"a", "b"}, -1, nil},
+	{[]string{"a", "b"}, 3, nil},
+	{[]string{"a", "b", "c"}, 1, [][]string{{"a"}, {"b"}, {"c"}}},
+	{[]string{"a", "b", "c"}, 2, [][]string{{"a", "b"}, {"a", "c"}, {"b", "c"}}},
+	{[]string{"a", "b", "c"}, 3, [][]string{{"a", "b", "c"}}},
+	{
+		[]string{"a", "b", "c", "d"},
+		2,
+		[][]string{
+			{"a", "b"}, {"a", "c"}, {"a", "d"},
+			{"b", "c"}, {"b", "d"}, {"c", "d"},
+		},
+	},
+}
+
+func TestCombinations(t *testing.T) {
+	for _, test := range combinationsTests {
+		t.Run("", func(t *testing.T) {
+			combinations, err := pie.Combinations(test.ss, test.k, 100)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, combinations)
+		})
+	}
+}
+
+func TestCombinationsTooMany(t *testing.T) {
+	_, err := pie.Combinations(pie.Sequence([]int{}, 10), 5, 251)
+	assert.EqualError(t, err, "too many results: 252 is more than 251")
+
+	combinations, err := pie.Combinations(pie.Sequence([]int{}, 10), 5, 252)
+	assert.NoError(t, err)
+	assert.Len(t, combinations, 252)
+
+	_, err = pie.Combinations(make([]int, 1000), 500, 1000)
+	assert.EqualError(t, err, "too many results: more than 1000")
+}
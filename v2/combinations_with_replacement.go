@@ -0,0 +1,22 @@
+package pie
+
+// CombinationsWithReplacement returns every way to choose k elements from ss,
+// where the order does not matter and each element can be chosen more than
+// once. See CombinationsWithReplacementSeq for the order.
+//
+// ErrTooManyResults is returned (without generating any combinations) if there
+// would be more than max.
+func CombinationsWithReplacement[T any](ss []T, k int, max int) ([][]T, error) {
+	count, ok := uint64(0), true
+	if k == 0 {
+		count = 1
+	} else if len(ss) > 0 {
+		count, ok = binomial(len(ss)+k-1, k)
+	}
+
+	if err := checkResults(count, ok, max); err != nil {
+		return nil, err
+	}
+
+	return collect(CombinationsWithReplacementSeq(ss, k), count), nil
+}
@@ -0,0 +1,40 @@
+package pie
+
+import "golang.org/x/exp/slices"
+
+// CombinationsWithReplacementSeq returns a sequence (that can be used as an
+// iter.Seq) of every way to choose k elements from ss, where the order does
+// not matter and each element can be chosen more than once:
+//
+//	CombinationsWithReplacementSeq([a, b, c], 2) => [a, a], [a, b], [a, c], [b, b], [b, c], [c, c]
+//
+// Nothing is produced if k is negative, or if ss is empty and k is greater
+// than zero. They are generated lazily and each one is a new slice.
+func CombinationsWithReplacementSeq[T any](ss []T, k int) func(yield func([]T) bool) {
+	ss = slices.Clone(ss)
+
+	return func(yield func([]T) bool) {
+		n := len(ss)
+		if k < 0 || (n == 0 && k > 0) {
+			return
+		}
+
+		indexes := make([]int, k)
+		for yield(pick(ss, indexes)) {
+			// Find the rightmost index that can still be increased.
+			i := k - 1
+			for i >= 0 && indexes[i] == n-1 {
+				i--
+			}
+
+			if i < 0 {
+				return
+			}
+
+			next := indexes[i] + 1
+			for j := i; j < k; j++ {
+				indexes[j] = next
+			}
+		}
+	}
+}
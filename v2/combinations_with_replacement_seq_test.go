@@ -0,0 +1,13 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCombinationsWithReplacementSeq(t *testing.T) {
+	assert.Equal(t, [][]int{{1, 1, 1}, {1, 1, 2}, {1, 1, 3}},
+		take(pie.CombinationsWithReplacementSeq([]int{1, 2, 3}, 3), 3))
+}
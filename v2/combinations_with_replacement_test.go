@@ -0,0 +1,47 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var combinationsWithReplacementTests = []struct {
+	ss       []string
+	k        int
+	expected [][]string
+}{
+	{nil, 0, [][]string{{}}},
+	{nil, 1, nil},
+	{[]string{"a"}, -1, nil},
+	{[]string{"a"}, 3, [][]string{{"a", "a", "a"}}},
+	{
+		[]string{"a", "b", "c"},
+		2,
+		[][]string{
+			{"a", "a"}, {"a", "b"}, {"a", "c"},
+			{"b", "b"}, {"b", "c"}, {"c", "c"},
+		},
+	},
+}
+
+func TestCombinationsWithReplacement(t *testing.T) {
+	for _, test := range combinationsWithReplacementTests {
+		t.Run("", func(t *testing.T) {
+			combinations, err := pie.CombinationsWithReplacement(test.ss, test.k, 100)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, combinations)
+		})
+	}
+}
+
+func TestCombinationsWithReplacementTooMany(t *testing.T) {
+	// C(4+3-1, 3) = 20
+	_, err := pie.CombinationsWithReplacement([]int{1, 2, 3, 4}, 3, 19)
+	assert.EqualError(t, err, "too many results: 20 is more than 19")
+
+	combinations, err := pie.CombinationsWithReplacement([]int{1, 2, 3, 4}, 3, 20)
+	assert.NoError(t, err)
+	assert.Len(t, combinations, 20)
+}
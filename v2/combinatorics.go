@@ -0,0 +1,92 @@
+package pie
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+// ErrTooManyResults is returned by the combinatorics functions (such as
+// Permutations) when the number of results would be more than the maximum
+// allowed. Use the Seq version of the function (such as PermutationsSeq) to
+// produce the results one at a time instead.
+var ErrTooManyResults = errors.New("too many results")
+
+// checkResults returns ErrTooManyResults if count is more than max. ok is
+// false if count overflowed.
+func checkResults(count uint64, ok bool, max int) error {
+	if !ok {
+		return fmt.Errorf("%w: more than %d", ErrTooManyResults, max)
+	}
+
+	if max < 0 || count > uint64(max) {
+		return fmt.Errorf("%w: %d is more than %d", ErrTooManyResults, count, max)
+	}
+
+	return nil
+}
+
+// collect returns all of the values produced by seq, or nil if there are none.
+// count is only used to allocate the slice.
+func collect[T any](seq func(yield func(T) bool), count uint64) []T {
+	var values []T
+	if count > 0 {
+		values = make([]T, 0, count)
+	}
+
+	seq(func(value T) bool {
+		values = append(values, value)
+		return true
+	})
+
+	return values
+}
+
+// pick returns a new slice of the elements at each of the indexes.
+func pick[T any](ss []T, indexes []int) []T {
+	picked := make([]T, len(indexes))
+	for i, index := range indexes {
+		picked[i] = ss[index]
+	}
+
+	return picked
+}
+
+func multiply(a, b uint64) (uint64, bool) {
+	hi, lo := bits.Mul64(a, b)
+
+	return lo, hi == 0
+}
+
+func factorial(n int) (uint64, bool) {
+	result, ok := uint64(1), true
+	for i := 2; i <= n && ok; i++ {
+		result, ok = multiply(result, uint64(i))
+	}
+
+	return result, ok
+}
+
+// binomial is the number of ways to choose k elements from n.
+func binomial(n, k int) (uint64, bool) {
+	if k < 0 || k > n {
+		return 0, true
+	}
+
+	if k > n-k {
+		k = n - k
+	}
+
+	// After each step result is binomial(n-k+i, i), so the division is exact.
+	result := uint64(1)
+	for i := 1; i <= k; i++ {
+		hi, lo := bits.Mul64(result, uint64(n-k+i))
+		if hi >= uint64(i) {
+			return 0, false
+		}
+
+		result, _ = bits.Div64(hi, lo, uint64(i))
+	}
+
+	return result, true
+}
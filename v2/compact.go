@@ -0,0 +1,19 @@
+package pie
+
+// Compact returns a new slice with all of the zero values (such as 0, "" or
+// nil) removed. The order of the remaining elements is retained.
+//
+// The returned slice may contain zero elements (nil).
+//
+// Compact is not the same as slices.Compact from the standard library, which
+// removes consecutive duplicates.
+func Compact[T comparable](ss []T) (ss2 []T) {
+	var zero T
+	for _, s := range ss {
+		if s != zero {
+			ss2 = append(ss2, s)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,18 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompact(t *testing.T) {
+	assert.Nil(t, pie.Compact([]int(nil)))
+	assert.Nil(t, pie.Compact([]int{0, 0}))
+	assert.Equal(t, []int{1, 2, 1}, pie.Compact([]int{0, 1, 2, 0, 1}))
+	assert.Equal(t, []string{"a", "b"}, pie.Compact([]string{"", "a", "", "b"}))
+
+	a, b := 1, 2
+	assert.Equal(t, []*int{&a, &b}, pie.Compact([]*int{nil, &a, nil, &b}))
+}
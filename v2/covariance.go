@@ -0,0 +1,48 @@
+package pie
+
+import "golang.org/x/exp/constraints"
+
+// Covariance is the population covariance of xs and ys, which is positive when
+// they tend to increase together and negative when one tends to decrease as the
+// other increases. Like Stddev, it divides by the number of elements.
+//
+// ErrUnequalLengths is returned if xs and ys are not the same length. Zero is
+// returned if there are no elements.
+func Covariance[T constraints.Integer | constraints.Float](xs, ys []T) (float64, error) {
+	if err := checkLengths(len(xs), len(ys)); err != nil {
+		return 0, err
+	}
+
+	if len(xs) == 0 {
+		return 0, nil
+	}
+
+	sxy, _, _ := sumsOfProducts(xs, ys)
+
+	return sxy / float64(len(xs)), nil
+}
+
+// sumsOfProducts returns the sums of (x - mean(x)) * (y - mean(y)), (x -
+// mean(x))^2 and (y - mean(y))^2. xs and ys must be the same length.
+func sumsOfProducts[T constraints.Integer | constraints.Float](xs, ys []T) (sxy, sxx, syy float64) {
+	meanX, meanY := mean(xs), mean(ys)
+	for i := range xs {
+		dx, dy := float64(xs[i])-meanX, float64(ys[i])-meanY
+		sxy += dx * dy
+		sxx += dx * dx
+		syy += dy * dy
+	}
+
+	return
+}
+
+// mean is the same as Average, except that the sum is a float64 so that it
+// cannot overflow for small integer types.
+func mean[T constraints.Integer | constraints.Float](ss []T) float64 {
+	var sum float64
+	for _, s := range ss {
+		sum += float64(s)
+	}
+
+	return sum / float64(len(ss))
+}
@@ -0,0 +1,41 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var covarianceTests = []struct {
+	xs, ys   []float64
+	expected float64
+}{
+	{nil, nil, 0},
+	{[]float64{1}, []float64{5}, 0},
+	{[]float64{1, 2, 3}, []float64{2, 4, 6}, 4.0 / 3},
+	{[]float64{1, 2, 3}, []float64{6, 4, 2}, -4.0 / 3},
+	{[]float64{1, 2, 3, 4}, []float64{1, 3, 2, 4}, 1},
+}
+
+func TestCovariance(t *testing.T) {
+	for _, test := range covarianceTests {
+		t.Run("", func(t *testing.T) {
+			covariance, err := pie.Covariance(test.xs, test.ys)
+			assert.NoError(t, err)
+			assert.InDelta(t, test.expected, covariance, 1e-12)
+		})
+	}
+}
+
+func TestCovarianceSmallIntegers(t *testing.T) {
+	// The sum of the elements would overflow an int8.
+	covariance, err := pie.Covariance([]int8{100, 120}, []int8{100, 120})
+	assert.NoError(t, err)
+	assert.Equal(t, 100.0, covariance)
+}
+
+func TestCovarianceUnequalLengths(t *testing.T) {
+	_, err := pie.Covariance([]int{1, 2}, []int{1})
+	assert.ErrorIs(t, err, pie.ErrUnequalLengths)
+}
@@ -0,0 +1,220 @@
+package pie
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// CSVColumn is a single column for ToCSV. Value formats the cell for each
+// element, in the same way as the transform function for StringsUsing.
+//
+// Columns can be created directly or from struct tags with CSVFields.
+type CSVColumn[T any] struct {
+	Name  string
+	Value func(T) string
+
+	// format is only set by CSVFields so that ToCSV can return the error from
+	// an encoding.TextMarshaler.
+	format func(T) (string, error)
+}
+
+// CSVError is returned by ToCSV and FromCSV when a value cannot be formatted
+// or parsed. Row starts at 1 for the header, so it matches the row number shown
+// in a spreadsheet unless a value contains a new line.
+type CSVError struct {
+	Row    int
+	Column string
+	Err    error
+}
+
+func (e *CSVError) Error() string {
+	return fmt.Sprintf("row %d, column %q: %v", e.Row, e.Column, e.Err)
+}
+
+func (e *CSVError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// csvField is a field that has been chosen as a column.
+type csvField struct {
+	name  string
+	index []int
+}
+
+// csvFields returns the columns for a struct (or a pointer to a struct) type.
+//
+// The column name is taken from the csv tag, otherwise the field name is used.
+// Unexported fields, fields tagged with "-" and the embedded structs themselves
+// are skipped. Fields of embedded structs are included as if they belonged to
+// the outer struct.
+func csvFields(typ reflect.Type) ([]csvField, error) {
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	if typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%v is not a struct", typ)
+	}
+
+	var fields []csvField
+	for _, field := range reflect.VisibleFields(typ) {
+		if !field.IsExported() || !csvReachable(typ, field.Index) {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("csv"), ",")
+		if name == "-" {
+			continue
+		}
+
+		if field.Anonymous && name == "" && !csvSupported(field.Type) {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		if !csvSupported(field.Type) {
+			return nil, fmt.Errorf("field %s has unsupported type %v", field.Name, field.Type)
+		}
+
+		fields = append(fields, csvField{name, field.Index})
+	}
+
+	return fields, nil
+}
+
+// csvReachable returns false if the field can only be reached through an
+// unexported embedded pointer, which could not be allocated by FromCSV.
+func csvReachable(typ reflect.Type, index []int) bool {
+	for _, i := range index[:len(index)-1] {
+		field := typ.Field(i)
+		if field.Type.Kind() == reflect.Ptr {
+			if !field.IsExported() {
+				return false
+			}
+
+			typ = field.Type.Elem()
+		} else {
+			typ = field.Type
+		}
+	}
+
+	return true
+}
+
+// csvSupported returns true if a value of typ can be formatted and parsed.
+func csvSupported(typ reflect.Type) bool {
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	if typ.Implements(textMarshalerType) && reflect.PtrTo(typ).Implements(textUnmarshalerType) {
+		return true
+	}
+
+	switch typ.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
+
+// formatCSVValue formats a value that is csvSupported. A nil pointer is an
+// empty string.
+func formatCSVValue(v reflect.Value) (string, error) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return "", nil
+		}
+
+		v = v.Elem()
+	}
+
+	if v.Type().Implements(textMarshalerType) {
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(text), err
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+
+	default: // reflect.Float32, reflect.Float64
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+	}
+}
+
+// parseCSVValue sets v, which is csvSupported, from the non-empty text of a
+// cell.
+func parseCSVValue(v reflect.Value, text string) error {
+	if v.Kind() == reflect.Ptr {
+		v.Set(reflect.New(v.Type().Elem()))
+		v = v.Elem()
+	}
+
+	if unmarshaler, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(text))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(text)
+
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+
+		v.SetBool(b)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(text, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		v.SetInt(i)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(text, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		v.SetUint(u)
+
+	default: // reflect.Float32, reflect.Float64
+		f, err := strconv.ParseFloat(text, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		v.SetFloat(f)
+	}
+
+	return nil
+}
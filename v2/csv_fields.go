@@ -0,0 +1,84 @@
+package pie
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// CSVFields returns the columns for the fields of a struct, so they can be
+// passed to ToCSV. T must be a struct or a pointer to a struct.
+//
+// The column name is taken from the csv tag (`csv:"name"`), otherwise the field
+// name is used. Fields tagged with `csv:"-"` and unexported fields are skipped.
+// Fields can be strings, bools, numbers, types that implement both
+// encoding.TextMarshaler and encoding.TextUnmarshaler (such as time.Time) or
+// pointers to any of these. A nil pointer is an empty cell.
+//
+// If no names are provided, every field is returned in the order they are
+// declared. Otherwise, only the named columns are returned in the same order as
+// names. The columns can be combined with other columns:
+//
+//	columns, err := pie.CSVFields[User]("id", "email")
+//	columns = append(columns, pie.CSVColumn[User]{
+//		Name:  "name",
+//		Value: func(u User) string { return u.First + " " + u.Last },
+//	})
+func CSVFields[T any](names ...string) ([]CSVColumn[T], error) {
+	fields, err := csvFields(reflect.TypeOf((*T)(nil)).Elem())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(names) > 0 {
+		byName := make(map[string]csvField, len(fields))
+		for _, field := range fields {
+			byName[field.name] = field
+		}
+
+		fields = make([]csvField, len(names))
+		for i, name := range names {
+			field, ok := byName[name]
+			if !ok {
+				return nil, fmt.Errorf("no field for column %q", name)
+			}
+
+			fields[i] = field
+		}
+	}
+
+	columns := make([]CSVColumn[T], len(fields))
+	for i, field := range fields {
+		format := csvFieldFormatter[T](field.index)
+		columns[i] = CSVColumn[T]{
+			Name: field.name,
+			Value: func(s T) string {
+				text, _ := format(s)
+				return text
+			},
+			format: format,
+		}
+	}
+
+	return columns, nil
+}
+
+// csvFieldFormatter returns a function that formats the field at index. The
+// field is an empty string if it cannot be reached because of a nil pointer.
+func csvFieldFormatter[T any](index []int) func(T) (string, error) {
+	return func(s T) (string, error) {
+		v := reflect.ValueOf(&s).Elem()
+		for _, i := range index {
+			if v.Kind() == reflect.Ptr {
+				if v.IsNil() {
+					return "", nil
+				}
+
+				v = v.Elem()
+			}
+
+			v = v.Field(i)
+		}
+
+		return formatCSVValue(v)
+	}
+}
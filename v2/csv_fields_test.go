@@ -0,0 +1,59 @@
+package pie_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type CSVAudit struct {
+	Created time.Time `csv:"created"`
+	Note    *string   `csv:"note,omitempty"`
+}
+
+type csvRecord struct {
+	ID     int     `csv:"id"`
+	Name   string  `csv:"name"`
+	Score  float64 `csv:"score"`
+	Active bool
+	Secret string `csv:"-"`
+	hidden string
+	*CSVAudit
+}
+
+type csvUnsupported struct {
+	Tags []string
+}
+
+func TestCSVFields(t *testing.T) {
+	columns, err := pie.CSVFields[csvRecord]()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id", "name", "score", "Active", "created", "note"},
+		pie.Map(columns, func(c pie.CSVColumn[csvRecord]) string { return c.Name }))
+
+	pointerColumns, err := pie.CSVFields[*csvRecord]("note", "id")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"note", "id"},
+		pie.Map(pointerColumns, func(c pie.CSVColumn[*csvRecord]) string { return c.Name }))
+
+	note := "hi"
+	record := &csvRecord{ID: 7, CSVAudit: &CSVAudit{Note: &note}}
+	assert.Equal(t, "hi", pointerColumns[0].Value(record))
+	assert.Equal(t, "7", pointerColumns[1].Value(record))
+	assert.Equal(t, "", pointerColumns[0].Value(&csvRecord{}))
+	assert.Equal(t, "", pointerColumns[1].Value(nil))
+}
+
+func TestCSVFieldsErrors(t *testing.T) {
+	_, err := pie.CSVFields[csvRecord]("id", "Secret")
+	assert.EqualError(t, err, `no field for column "Secret"`)
+
+	_, err = pie.CSVFields[int]()
+	assert.EqualError(t, err, "int is not a struct")
+
+	_, err = pie.CSVFields[csvUnsupported]()
+	assert.EqualError(t, err, "field Tags has unsupported type []string")
+}
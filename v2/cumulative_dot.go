@@ -0,0 +1,46 @@
+package pie
+
+import "golang.org/x/exp/constraints"
+
+// CumulativeDot returns the running dot product of ss1 and ss2. Each value is
+// the Dot of the elements up to and including that position, in the same way
+// as CumulativeSum:
+//
+//	CumulativeDot([1, 2, 3], [4, 5, 6]) => [4, 14, 32]
+//
+// ErrUnequalLengths is returned if ss1 and ss2 are not the same length. nil is
+// returned if there are no elements.
+func CumulativeDot[T constraints.Integer | constraints.Float](ss1, ss2 []T) ([]T, error) {
+	if err := checkLengths(len(ss1), len(ss2)); err != nil {
+		return nil, err
+	}
+
+	if len(ss1) == 0 {
+		return nil, nil
+	}
+
+	result := make([]T, len(ss1))
+
+	// Each value depends on the one before it, so unlike Dot there can only be
+	// one total. Unrolling still removes the bounds checks.
+	var sum T
+	i := 0
+	for ; i+4 <= len(result); i += 4 {
+		r, a, b := result[i:i+4:i+4], ss1[i:i+4:i+4], ss2[i:i+4:i+4]
+		sum += a[0] * b[0]
+		r[0] = sum
+		sum += a[1] * b[1]
+		r[1] = sum
+		sum += a[2] * b[2]
+		r[2] = sum
+		sum += a[3] * b[3]
+		r[3] = sum
+	}
+
+	for ; i < len(result); i++ {
+		sum += ss1[i] * ss2[i]
+		result[i] = sum
+	}
+
+	return result, nil
+}
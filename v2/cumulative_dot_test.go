@@ -0,0 +1,32 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var cumulativeDotTests = []struct {
+	ss1, ss2 []int
+	expected []int
+}{
+	{nil, nil, nil},
+	{[]int{1, 2, 3}, []int{4, 5, 6}, []int{4, 14, 32}},
+	{[]int{1, 2, 3, 4, 5, 6}, []int{1, 1, 1, 1, 1, -1}, []int{1, 3, 6, 10, 15, 9}},
+}
+
+func TestCumulativeDot(t *testing.T) {
+	for _, test := range cumulativeDotTests {
+		t.Run("", func(t *testing.T) {
+			result, err := pie.CumulativeDot(test.ss1, test.ss2)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestCumulativeDotUnequalLengths(t *testing.T) {
+	_, err := pie.CumulativeDot([]int{1, 2}, []int{1})
+	assert.ErrorIs(t, err, pie.ErrUnequalLengths)
+}
@@ -0,0 +1,29 @@
+package pie
+
+import "golang.org/x/exp/constraints"
+
+// CumulativeMax returns the running maximum of the elements. Each value is the
+// largest of the element at the same position and all of the elements before
+// it.
+//
+// nil is returned if there are no elements in the slice.
+//
+// Examples:
+//
+//	CumulativeMax([3, 1, 4, 1, 5]) => [3, 3, 4, 4, 5]
+func CumulativeMax[T constraints.Ordered](ss []T) []T {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	result := make([]T, len(ss))
+	max := ss[0]
+	for i, s := range ss {
+		if s > max {
+			max = s
+		}
+		result[i] = max
+	}
+
+	return result
+}
@@ -0,0 +1,29 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var cumulativeMaxTests = []struct {
+	ss       []int
+	expected []int
+}{
+	{nil, nil},
+	{[]int{}, nil},
+	{[]int{-5}, []int{-5}},
+	{[]int{3, 1, 4, 1, 5}, []int{3, 3, 4, 4, 5}},
+	{[]int{5, 4, 3}, []int{5, 5, 5}},
+}
+
+func TestCumulativeMax(t *testing.T) {
+	for _, test := range cumulativeMaxTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.CumulativeMax(test.ss))
+		})
+	}
+
+	assert.Equal(t, []string{"b", "b", "c"}, pie.CumulativeMax([]string{"b", "a", "c"}))
+}
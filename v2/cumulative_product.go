@@ -0,0 +1,27 @@
+package pie
+
+import "golang.org/x/exp/constraints"
+
+// CumulativeProduct returns the running product of the elements. Each value is
+// the product of the element at the same position and all of the elements
+// before it.
+//
+// nil is returned if there are no elements in the slice.
+//
+// Examples:
+//
+//	CumulativeProduct([1, 2, 3, 4]) => [1, 2, 6, 24]
+func CumulativeProduct[T constraints.Integer | constraints.Float](ss []T) []T {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	result := make([]T, len(ss))
+	product := T(1)
+	for i, s := range ss {
+		product *= s
+		result[i] = product
+	}
+
+	return result
+}
@@ -0,0 +1,27 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var cumulativeProductTests = []struct {
+	ss       []int
+	expected []int
+}{
+	{nil, nil},
+	{[]int{}, nil},
+	{[]int{5}, []int{5}},
+	{[]int{1, 2, 3, 4}, []int{1, 2, 6, 24}},
+	{[]int{2, 0, 3}, []int{2, 0, 0}},
+}
+
+func TestCumulativeProduct(t *testing.T) {
+	for _, test := range cumulativeProductTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.CumulativeProduct(test.ss))
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package pie
+
+import "golang.org/x/exp/constraints"
+
+// CumulativeSum returns the running total of the elements. Each value is the
+// sum of the element at the same position and all of the elements before it.
+//
+// nil is returned if there are no elements in the slice.
+//
+// Examples:
+//
+//	CumulativeSum([1, 2, 3, 4]) => [1, 3, 6, 10]
+func CumulativeSum[T constraints.Integer | constraints.Float](ss []T) []T {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	result := make([]T, len(ss))
+	var sum T
+	for i, s := range ss {
+		sum += s
+		result[i] = sum
+	}
+
+	return result
+}
@@ -0,0 +1,27 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var cumulativeSumTests = []struct {
+	ss       []float64
+	expected []float64
+}{
+	{nil, nil},
+	{[]float64{}, nil},
+	{[]float64{1.5}, []float64{1.5}},
+	{[]float64{1, 2, 3, 4}, []float64{1, 3, 6, 10}},
+	{[]float64{1, -2, 3, -4}, []float64{1, -1, 2, -2}},
+}
+
+func TestCumulativeSum(t *testing.T) {
+	for _, test := range cumulativeSumTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.CumulativeSum(test.ss))
+		})
+	}
+}
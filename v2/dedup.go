@@ -0,0 +1,26 @@
+package pie
+
+import "golang.org/x/exp/slices"
+
+// Dedup returns a new slice where each run of consecutive equal elements is
+// replaced with the first element of the run. Unlike Unique, elements that are
+// equal but not next to each other are kept, and the order is unchanged:
+//
+//	Dedup([a, a, b, b, b, a, c]) => [a, b, a, c]
+//
+// Sort the slice first to remove all duplicates. See inplace.Dedup to modify
+// the slice instead of allocating a new one.
+func Dedup[T comparable](ss []T) []T {
+	if len(ss) < 2 {
+		return slices.Clone(ss)
+	}
+
+	result := []T{ss[0]}
+	for i := 1; i < len(ss); i++ {
+		if ss[i] != ss[i-1] {
+			result = append(result, ss[i])
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,27 @@
+package pie
+
+import "golang.org/x/exp/slices"
+
+// DedupBy works like Dedup, except that consecutive elements are equal when
+// they have the same key. The first element of each run is kept:
+//
+//	DedupBy(["apple", "avocado", "banana", "apricot"], firstLetter)
+//	  => ["apple", "banana", "apricot"]
+//
+// The key function is called once for each element.
+func DedupBy[T any, K comparable](ss []T, key func(T) K) []T {
+	if len(ss) < 2 {
+		return slices.Clone(ss)
+	}
+
+	result := []T{ss[0]}
+	previous := key(ss[0])
+	for _, s := range ss[1:] {
+		if k := key(s); k != previous {
+			result = append(result, s)
+			previous = k
+		}
+	}
+
+	return result
+}
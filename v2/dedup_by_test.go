@@ -0,0 +1,37 @@
+package pie_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var dedupByTests = []struct {
+	ss       []string
+	expected []string
+}{
+	{nil, nil},
+	{[]string{"Apple"}, []string{"Apple"}},
+	{[]string{"apple", "APPLE", "Apple"}, []string{"apple"}},
+	{[]string{"a", "A", "b", "a", "B", "b"}, []string{"a", "b", "a", "B"}},
+}
+
+func TestDedupBy(t *testing.T) {
+	for _, test := range dedupByTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.DedupBy(test.ss, strings.ToLower))
+		})
+	}
+}
+
+func TestDedupByCallsKeyOnce(t *testing.T) {
+	calls := 0
+	pie.DedupBy([]int{1, 1, 2, 3, 3}, func(i int) int {
+		calls++
+		return i
+	})
+
+	assert.Equal(t, 5, calls)
+}
@@ -0,0 +1,37 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/elliotchance/pie/v2/inplace"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/exp/slices"
+)
+
+var dedupTests = []struct {
+	ss       []string
+	expected []string
+}{
+	{nil, nil},
+	{[]string{}, []string{}},
+	{[]string{"a"}, []string{"a"}},
+	{[]string{"a", "a", "a"}, []string{"a"}},
+	{[]string{"a", "a", "b", "b", "b", "a", "c"}, []string{"a", "b", "a", "c"}},
+	{[]string{"a", "b", "c"}, []string{"a", "b", "c"}},
+}
+
+func TestDedup(t *testing.T) {
+	for _, test := range dedupTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.Dedup(test.ss))
+		})
+	}
+}
+
+func TestDedupMatchesInplace(t *testing.T) {
+	for _, test := range dedupTests {
+		ss := slices.Clone(test.ss)
+		assert.Equal(t, pie.Dedup(test.ss), inplace.Dedup(ss))
+	}
+}
@@ -1,21 +1,31 @@
 package pie
 
-import "sort"
+import (
+	"sort"
+
+	"golang.org/x/exp/slices"
+)
 
 // Removes elements at indices in idx from input slice, returns resulting slice.
 // If an index is out of bounds, skip it.
 func Delete[T any](ss []T, idx ...int) []T {
-	// short path O(n) inplace
+	// short path O(n)
 	if len(idx) == 1 {
 		i := idx[0]
 
 		if i < 0 || i >= len(ss) {
-			return ss
+			return slices.Clone(ss)
 		}
-		return append(ss[:i], ss[i+1:]...)
+
+		ss2 := make([]T, 0, len(ss)-1)
+		ss2 = append(ss2, ss[:i]...)
+
+		return append(ss2, ss[i+1:]...)
 	}
 
 	// long path O(mLog(m) + n)
+	// The indices are copied so that the caller's slice is not reordered.
+	idx = slices.Clone(idx)
 	sort.Ints(idx)
 
 	ss2 := make([]T, 0, len(ss))
@@ -59,3 +59,9 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteDoesNotSortIndices(t *testing.T) {
+	idx := []int{3, 0}
+	assert.Equal(t, []int{2, 3, 5}, pie.Delete([]int{1, 2, 3, 4, 5}, idx...))
+	assert.Equal(t, []int{3, 0}, idx)
+}
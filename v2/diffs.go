@@ -0,0 +1,29 @@
+package pie
+
+import "golang.org/x/exp/constraints"
+
+// Diffs returns the differences between adjacent elements, that is
+// ss[i+1]-ss[i] for each position. The result has one less element than the
+// input.
+//
+// nil is returned if there are less than two elements in the slice.
+//
+// Diffs is the inverse of CumulativeSum, apart from the first element.
+//
+// Examples:
+//
+//	Diffs([1, 3, 6, 10]) => [2, 3, 4]
+//	Diffs([5, 2])        => [-3]
+//	Diffs([5])           => []
+func Diffs[T constraints.Integer | constraints.Float](ss []T) []T {
+	if len(ss) < 2 {
+		return nil
+	}
+
+	result := make([]T, len(ss)-1)
+	for i := range result {
+		result[i] = ss[i+1] - ss[i]
+	}
+
+	return result
+}
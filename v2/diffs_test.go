@@ -0,0 +1,27 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var diffsTests = []struct {
+	ss       []int
+	expected []int
+}{
+	{nil, nil},
+	{[]int{}, nil},
+	{[]int{5}, nil},
+	{[]int{5, 2}, []int{-3}},
+	{[]int{1, 3, 6, 10}, []int{2, 3, 4}},
+}
+
+func TestDiffs(t *testing.T) {
+	for _, test := range diffsTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.Diffs(test.ss))
+		})
+	}
+}
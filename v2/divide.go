@@ -0,0 +1,41 @@
+package pie
+
+import "golang.org/x/exp/constraints"
+
+// Divide returns the elements of ss1 divided by the element at the same position
+// of ss2:
+//
+//	Divide([10, 20, 30], [2, 4, 5]) => [5, 5, 6]
+//
+// ErrUnequalLengths is returned if ss1 and ss2 are not the same length. nil is
+// returned if there are no elements.
+//
+// Like the / operator, integers are truncated and dividing an integer by zero
+// will panic. Dividing a float by zero gives an infinity (or NaN for 0/0).
+func Divide[T constraints.Integer | constraints.Float](ss1, ss2 []T) ([]T, error) {
+	if err := checkLengths(len(ss1), len(ss2)); err != nil {
+		return nil, err
+	}
+
+	if len(ss1) == 0 {
+		return nil, nil
+	}
+
+	result := make([]T, len(ss1))
+
+	// Unrolled in the same way as Add.
+	i := 0
+	for ; i+4 <= len(result); i += 4 {
+		r, a, b := result[i:i+4:i+4], ss1[i:i+4:i+4], ss2[i:i+4:i+4]
+		r[0] = a[0] / b[0]
+		r[1] = a[1] / b[1]
+		r[2] = a[2] / b[2]
+		r[3] = a[3] / b[3]
+	}
+
+	for ; i < len(result); i++ {
+		result[i] = ss1[i] / ss2[i]
+	}
+
+	return result, nil
+}
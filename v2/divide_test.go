@@ -0,0 +1,43 @@
+package pie_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var divideTests = []struct {
+	ss1, ss2 []float64
+	expected []float64
+}{
+	{nil, nil, nil},
+	{[]float64{10, 20, 30}, []float64{2, 4, 5}, []float64{5, 5, 6}},
+	{[]float64{1, 2, 3, 4, -1}, []float64{2, 2, 2, 2, 0}, []float64{0.5, 1, 1.5, 2, math.Inf(-1)}},
+}
+
+func TestDivide(t *testing.T) {
+	for _, test := range divideTests {
+		t.Run("", func(t *testing.T) {
+			result, err := pie.Divide(test.ss1, test.ss2)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestDivideIntegers(t *testing.T) {
+	result, err := pie.Divide([]int{7, -7}, []int{2, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, -3}, result)
+
+	assert.Panics(t, func() {
+		pie.Divide([]int{1}, []int{0})
+	})
+}
+
+func TestDivideUnequalLengths(t *testing.T) {
+	_, err := pie.Divide([]int{1}, []int{1, 2})
+	assert.ErrorIs(t, err, pie.ErrUnequalLengths)
+}
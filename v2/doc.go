@@ -0,0 +1,16 @@
+// Package pie is a library of utility functions for common operations on
+// slices and maps.
+//
+// The functions never modify the slices they are given, and the slices they
+// return never share memory with them. It is always safe to modify or append
+// to a returned slice. The only exceptions are Pop, which takes a pointer to
+// the slice so that it can remove the first element, and the destination slice
+// of the Append functions (such as AppendFilter), which can be reused to avoid
+// allocations. The inplace package has versions of some functions that modify
+// the slice instead.
+//
+// The chaining wrappers (Of, OfComparable, OfOrdered and OfNumeric) are
+// generated from the functions in this package by running go generate.
+package pie
+
+//go:generate go run generate.go
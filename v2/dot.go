@@ -0,0 +1,36 @@
+package pie
+
+import "golang.org/x/exp/constraints"
+
+// Dot returns the dot product of ss1 and ss2, which is the sum of the products
+// of the elements at each position:
+//
+//	Dot([1, 2, 3], [4, 5, 6]) => 32
+//
+// ErrUnequalLengths is returned if ss1 and ss2 are not the same length. Zero is
+// returned if there are no elements.
+//
+// The products are added to four separate totals that are combined at the end.
+// This is about twice as fast as a single total (see BenchmarkVectorDot), but
+// for floats the result may be slightly different from adding them in order.
+func Dot[T constraints.Integer | constraints.Float](ss1, ss2 []T) (T, error) {
+	if err := checkLengths(len(ss1), len(ss2)); err != nil {
+		return 0, err
+	}
+
+	var sum0, sum1, sum2, sum3 T
+	i := 0
+	for ; i+4 <= len(ss1); i += 4 {
+		a, b := ss1[i:i+4:i+4], ss2[i:i+4:i+4]
+		sum0 += a[0] * b[0]
+		sum1 += a[1] * b[1]
+		sum2 += a[2] * b[2]
+		sum3 += a[3] * b[3]
+	}
+
+	for ; i < len(ss1); i++ {
+		sum0 += ss1[i] * ss2[i]
+	}
+
+	return (sum0 + sum1) + (sum2 + sum3), nil
+}
@@ -0,0 +1,33 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var dotTests = []struct {
+	ss1, ss2 []int
+	expected int
+}{
+	{nil, nil, 0},
+	{[]int{3}, []int{4}, 12},
+	{[]int{1, 2, 3}, []int{4, 5, 6}, 32},
+	{[]int{1, 2, 3, 4, 5, 6, 7}, []int{1, 1, 1, 1, 1, 1, -1}, 14},
+}
+
+func TestDot(t *testing.T) {
+	for _, test := range dotTests {
+		t.Run("", func(t *testing.T) {
+			dot, err := pie.Dot(test.ss1, test.ss2)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, dot)
+		})
+	}
+}
+
+func TestDotUnequalLengths(t *testing.T) {
+	_, err := pie.Dot([]int{1, 2}, []int{1})
+	assert.ErrorIs(t, err, pie.ErrUnequalLengths)
+}
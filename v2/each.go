@@ -1,7 +1,9 @@
 package pie
 
+import "golang.org/x/exp/slices"
+
 // Each is more condensed version of Transform that allows an action to happen
-// on each elements and pass the original slice on.
+// on each elements and pass a copy of the slice on.
 //
 //   pie.Each(cars, func (car *Car) {
 //       fmt.Printf("Car color is: %s\n", car.Color)
@@ -20,5 +22,5 @@ func Each[T any](ss []T, fn func(T)) []T {
 		fn(s)
 	}
 
-	return ss
+	return slices.Clone(ss)
 }
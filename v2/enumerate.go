@@ -0,0 +1,14 @@
+package pie
+
+// Enumerate returns a new slice of pairs, where A is the index and B is the
+// element of ss at that index:
+//
+//	Enumerate([a, b, c]) => [{0, a}, {1, b}, {2, c}]
+func Enumerate[T any](ss []T) []Zipped[int, T] {
+	enumerated := make([]Zipped[int, T], len(ss))
+	for i, s := range ss {
+		enumerated[i] = Zipped[int, T]{i, s}
+	}
+
+	return enumerated
+}
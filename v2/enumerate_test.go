@@ -0,0 +1,25 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var enumerateTests = []struct {
+	ss       []string
+	expected []pie.Zipped[int, string]
+}{
+	{nil, []pie.Zipped[int, string]{}},
+	{[]string{"a"}, []pie.Zipped[int, string]{{0, "a"}}},
+	{[]string{"a", "b", "c"}, []pie.Zipped[int, string]{{0, "a"}, {1, "b"}, {2, "c"}}},
+}
+
+func TestEnumerate(t *testing.T) {
+	for _, test := range enumerateTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.Enumerate(test.ss))
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package pie
+
+import "golang.org/x/exp/constraints"
+
+// ExponentialMovingAverage returns the exponential moving average of the
+// elements, using alpha as the smoothing factor.
+//
+// The first value is the first element and each subsequent value is:
+//
+//	alpha*ss[i] + (1-alpha)*previous
+//
+// A larger alpha discounts older elements faster. An alpha of 1 returns the
+// elements unchanged. It will panic if alpha is not in the range (0, 1].
+//
+// nil is returned if there are no elements in the slice.
+func ExponentialMovingAverage[T constraints.Integer | constraints.Float](ss []T, alpha float64) []float64 {
+	if !(alpha > 0 && alpha <= 1) {
+		panic("alpha should be in the range (0, 1]")
+	}
+
+	if len(ss) == 0 {
+		return nil
+	}
+
+	result := make([]float64, len(ss))
+	result[0] = float64(ss[0])
+	for i := 1; i < len(ss); i++ {
+		result[i] = alpha*float64(ss[i]) + (1-alpha)*result[i-1]
+	}
+
+	return result
+}
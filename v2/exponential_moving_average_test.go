@@ -0,0 +1,34 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var exponentialMovingAverageTests = []struct {
+	ss       []int
+	alpha    float64
+	expected []float64
+}{
+	{nil, 0.5, nil},
+	{[]int{}, 0.5, nil},
+	{[]int{4}, 0.5, []float64{4}},
+	{[]int{4, 8, 2, 10}, 0.5, []float64{4, 6, 4, 7}},
+	{[]int{4, 8, 2, 10}, 1, []float64{4, 8, 2, 10}},
+}
+
+func TestExponentialMovingAverage(t *testing.T) {
+	for _, test := range exponentialMovingAverageTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.ExponentialMovingAverage(test.ss, test.alpha))
+		})
+	}
+
+	for _, alpha := range []float64{0, -0.5, 1.5} {
+		assert.PanicsWithValue(t, "alpha should be in the range (0, 1]", func() {
+			pie.ExponentialMovingAverage([]int{1, 2, 3}, alpha)
+		})
+	}
+}
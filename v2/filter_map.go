@@ -0,0 +1,22 @@
+package pie
+
+// FilterMap maps and filters in a single pass. The function returns the mapped
+// value and whether it should be included in the result.
+//
+// The returned slice may contain zero elements (nil).
+//
+// For example, parsing only the valid numbers:
+//
+//	pie.FilterMap([]string{"1", "x", "3"}, func(s string) (int, bool) {
+//	    i, err := strconv.Atoi(s)
+//	    return i, err == nil
+//	}) // [1 3]
+func FilterMap[T any, U any](ss []T, fn func(T) (U, bool)) (ss2 []U) {
+	for _, s := range ss {
+		if u, ok := fn(s); ok {
+			ss2 = append(ss2, u)
+		}
+	}
+
+	return
+}
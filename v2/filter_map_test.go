@@ -0,0 +1,20 @@
+package pie_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterMap(t *testing.T) {
+	atoi := func(s string) (int, bool) {
+		i, err := strconv.Atoi(s)
+		return i, err == nil
+	}
+
+	assert.Nil(t, pie.FilterMap(nil, atoi))
+	assert.Nil(t, pie.FilterMap([]string{"x", "y"}, atoi))
+	assert.Equal(t, []int{1, 3}, pie.FilterMap([]string{"1", "x", "3"}, atoi))
+}
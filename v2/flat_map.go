@@ -0,0 +1,18 @@
+package pie
+
+// FlatMap maps each element to a slice and concatenates the results into a
+// single slice. It is equivalent to Flat(Map(ss, fn)) without the intermediate
+// two-dimensional slice.
+//
+// The returned slice may contain zero elements (nil).
+//
+// Examples:
+//
+//	FlatMap(["a b", "c"], strings.Fields) => ["a", "b", "c"]
+func FlatMap[T any, U any](ss []T, fn func(T) []U) (ss2 []U) {
+	for _, s := range ss {
+		ss2 = append(ss2, fn(s)...)
+	}
+
+	return
+}
@@ -0,0 +1,19 @@
+package pie_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatMap(t *testing.T) {
+	assert.Nil(t, pie.FlatMap(nil, strings.Fields))
+	assert.Nil(t, pie.FlatMap([]string{"", " "}, strings.Fields))
+	assert.Equal(t, []string{"a", "b", "c"}, pie.FlatMap([]string{"a b", "", "c"}, strings.Fields))
+
+	assert.Equal(t, []int{1, 1, 2, 1, 2, 3}, pie.FlatMap([]int{1, 2, 3}, func(n int) []int {
+		return pie.Sequence([]int{}, 1, n+1)
+	}))
+}
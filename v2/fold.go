@@ -0,0 +1,21 @@
+package pie
+
+// Fold applies fn to each element from left to right, threading an
+// accumulator that starts as initial. Unlike Reduce, the accumulator may be a
+// different type to the elements.
+//
+// initial is returned if there are no elements in the slice.
+//
+// For example, summarising orders without converting them first:
+//
+//	total := pie.Fold(orders, 0.0, func(total float64, o Order) float64 {
+//	    return total + o.Amount
+//	})
+func Fold[T, A any](ss []T, initial A, fn func(A, T) A) A {
+	acc := initial
+	for _, s := range ss {
+		acc = fn(acc, s)
+	}
+
+	return acc
+}
@@ -0,0 +1,14 @@
+package pie
+
+// FoldRight is the same as Fold except the elements are visited from right to
+// left, starting at the last element.
+//
+// initial is returned if there are no elements in the slice.
+func FoldRight[T, A any](ss []T, initial A, fn func(A, T) A) A {
+	acc := initial
+	for i := len(ss) - 1; i >= 0; i-- {
+		acc = fn(acc, ss[i])
+	}
+
+	return acc
+}
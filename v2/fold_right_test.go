@@ -0,0 +1,22 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFoldRight(t *testing.T) {
+	concat := func(acc, s string) string {
+		return acc + s
+	}
+
+	assert.Equal(t, "z", pie.FoldRight(nil, "z", concat))
+	assert.Equal(t, "zcba", pie.FoldRight([]string{"a", "b", "c"}, "z", concat))
+
+	assert.Equal(t, 3, pie.FoldRight([]string{"a", "b", "c"}, 0,
+		func(acc int, _ string) int {
+			return acc + 1
+		}))
+}
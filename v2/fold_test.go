@@ -0,0 +1,33 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type order struct {
+	Item   string
+	Amount float64
+}
+
+type orderSummary struct {
+	Count int
+	Total float64
+}
+
+func TestFold(t *testing.T) {
+	summarize := func(s orderSummary, o order) orderSummary {
+		return orderSummary{s.Count + 1, s.Total + o.Amount}
+	}
+
+	assert.Equal(t, orderSummary{}, pie.Fold(nil, orderSummary{}, summarize))
+	assert.Equal(t, orderSummary{2, 15.5},
+		pie.Fold([]order{{"a", 10}, {"b", 5.5}}, orderSummary{}, summarize))
+
+	assert.Equal(t, "abc", pie.Fold([]string{"a", "b", "c"}, "",
+		func(acc, s string) string {
+			return acc + s
+		}))
+}
@@ -0,0 +1,115 @@
+package pie
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// FromCSV reads CSV written by ToCSV (or a spreadsheet) into a slice of
+// structs. T must be a struct or a pointer to a struct, and the columns are
+// matched to the fields in the same way as CSVFields.
+//
+// The first row must be the header. Columns can be in any order, columns that
+// do not match a field are ignored and fields without a column are left as the
+// zero value. An empty cell is also the zero value (or nil for a pointer).
+//
+// A *CSVError, with the row and column, is returned if a value cannot be
+// parsed. The underlying error can be found with errors.As, for example a
+// *strconv.NumError for an invalid number. Malformed CSV returns the
+// *csv.ParseError from encoding/csv.
+//
+// An empty input returns a nil slice.
+func FromCSV[T any](r io.Reader) ([]T, error) {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	fields, err := csvFields(typ)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(header) > 0 {
+		// Excel adds a byte order mark to UTF-8 files.
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+
+	byName := make(map[string]csvField, len(fields))
+	for _, field := range fields {
+		byName[field.name] = field
+	}
+
+	columns := make([]*csvField, len(header))
+	seen := make(map[string]bool, len(header))
+	for i, name := range header {
+		if seen[name] {
+			return nil, fmt.Errorf("duplicate column %q", name)
+		}
+
+		seen[name] = true
+		if field, ok := byName[name]; ok {
+			columns[i] = &field
+		}
+	}
+
+	var ss []T
+	for row := 2; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return ss, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		var s T
+		v := reflect.ValueOf(&s).Elem()
+		if typ.Kind() == reflect.Ptr {
+			v.Set(reflect.New(typ.Elem()))
+		}
+
+		for i, text := range record {
+			// Empty cells are skipped so that embedded pointers are not
+			// allocated without any of their fields being set.
+			if columns[i] == nil || text == "" {
+				continue
+			}
+
+			err := parseCSVValue(csvFieldValue(v, columns[i].index), text)
+			if err != nil {
+				return nil, &CSVError{Row: row, Column: header[i], Err: err}
+			}
+		}
+
+		ss = append(ss, s)
+	}
+}
+
+// csvFieldValue returns the field at index, allocating any nil pointers to
+// embedded structs on the way.
+func csvFieldValue(v reflect.Value, index []int) reflect.Value {
+	for _, i := range index {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+
+			v = v.Elem()
+		}
+
+		v = v.Field(i)
+	}
+
+	return v
+}
@@ -0,0 +1,67 @@
+package pie_test
+
+import (
+	"encoding/csv"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type csvName struct {
+	First string `csv:"first"`
+}
+
+type csvPerson struct {
+	csvName
+	Age   uint8 `csv:"age"`
+	Email *string
+}
+
+func TestFromCSV(t *testing.T) {
+	email := "bob@example.com"
+
+	people, err := pie.FromCSV[*csvPerson](strings.NewReader(
+		"\ufeffage,unknown,first,Email\n" +
+			"23,x,Bob,bob@example.com\n" +
+			",,Jane,\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, []*csvPerson{
+		{csvName{"Bob"}, 23, &email},
+		{csvName{"Jane"}, 0, nil},
+	}, people)
+}
+
+func TestFromCSVEmpty(t *testing.T) {
+	people, err := pie.FromCSV[csvPerson](strings.NewReader(""))
+	assert.NoError(t, err)
+	assert.Nil(t, people)
+
+	people, err = pie.FromCSV[csvPerson](strings.NewReader("first,age\n"))
+	assert.NoError(t, err)
+	assert.Nil(t, people)
+}
+
+func TestFromCSVErrors(t *testing.T) {
+	_, err := pie.FromCSV[csvPerson](strings.NewReader("first,age\nBob,23\nJane,300\n"))
+	var csvErr *pie.CSVError
+	assert.ErrorAs(t, err, &csvErr)
+	assert.Equal(t, 3, csvErr.Row)
+	assert.Equal(t, "age", csvErr.Column)
+	assert.EqualError(t, err, `row 3, column "age": strconv.ParseUint: parsing "300": value out of range`)
+
+	var numErr *strconv.NumError
+	assert.ErrorAs(t, err, &numErr)
+
+	_, err = pie.FromCSV[csvPerson](strings.NewReader("first,first\n"))
+	assert.EqualError(t, err, `duplicate column "first"`)
+
+	_, err = pie.FromCSV[csvPerson](strings.NewReader("first,age\nBob\n"))
+	var parseErr *csv.ParseError
+	assert.ErrorAs(t, err, &parseErr)
+
+	_, err = pie.FromCSV[string](strings.NewReader("first\n"))
+	assert.EqualError(t, err, "string is not a struct")
+}
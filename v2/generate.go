@@ -0,0 +1,15 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import "github.com/elliotchance/pie/v2/internal/ofgen"
+
+// Generates of.go, of_comparable.go, of_ordered.go, of_numeric.go and
+// of_generated_test.go from the functions in this package. See the ofgen
+// package for details.
+func main() {
+	if err := ofgen.Write("."); err != nil {
+		panic(err)
+	}
+}
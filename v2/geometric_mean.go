@@ -0,0 +1,26 @@
+package pie
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// GeometricMean is the nth root of the product of the n elements. It is
+// suited to values that are multiplied together, such as growth rates.
+//
+// It is calculated from the logarithms of the elements so that the product
+// cannot overflow. Zero is returned if there are no elements or any element is
+// zero. NaN is returned if any element is negative.
+func GeometricMean[T constraints.Integer | constraints.Float](ss []T) float64 {
+	if len(ss) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for _, s := range ss {
+		sum += math.Log(float64(s))
+	}
+
+	return math.Exp(sum / float64(len(ss)))
+}
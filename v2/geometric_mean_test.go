@@ -0,0 +1,34 @@
+package pie_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var geometricMeanTests = []struct {
+	ss       []float64
+	expected float64
+}{
+	{nil, 0},
+	{[]float64{5}, 5},
+	{[]float64{2, 8}, 4},
+	{[]float64{1, 3, 9, 27, 81}, 9},
+	{[]float64{4, 0, 2}, 0},
+	// The product would overflow a float64.
+	{[]float64{1e200, 1e200, 1e200}, 1e200},
+}
+
+func TestGeometricMean(t *testing.T) {
+	for _, test := range geometricMeanTests {
+		t.Run("", func(t *testing.T) {
+			assert.InEpsilon(t, test.expected+1, pie.GeometricMean(test.ss)+1, 1e-12)
+		})
+	}
+}
+
+func TestGeometricMeanNegative(t *testing.T) {
+	assert.True(t, math.IsNaN(pie.GeometricMean([]int{2, -8})))
+}
@@ -0,0 +1,35 @@
+package pie
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// HarmonicMean is the number of elements divided by the sum of the reciprocals
+// of the elements. It is suited to rates, such as the average speed over equal
+// distances.
+//
+// Zero is returned if there are no elements or any element is zero. NaN is
+// returned if any element is negative.
+func HarmonicMean[T constraints.Integer | constraints.Float](ss []T) float64 {
+	if len(ss) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for _, s := range ss {
+		value := float64(s)
+		switch {
+		case value < 0:
+			return math.NaN()
+
+		case value == 0:
+			return 0
+		}
+
+		sum += 1 / value
+	}
+
+	return float64(len(ss)) / sum
+}
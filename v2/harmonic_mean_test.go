@@ -0,0 +1,32 @@
+package pie_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var harmonicMeanTests = []struct {
+	ss       []float64
+	expected float64
+}{
+	{nil, 0},
+	{[]float64{5}, 5},
+	{[]float64{1, 4, 4}, 2},
+	{[]float64{60, 40}, 48},
+	{[]float64{1, 0, 2}, 0},
+}
+
+func TestHarmonicMean(t *testing.T) {
+	for _, test := range harmonicMeanTests {
+		t.Run("", func(t *testing.T) {
+			assert.InDelta(t, test.expected, pie.HarmonicMean(test.ss), 1e-12)
+		})
+	}
+}
+
+func TestHarmonicMeanNegative(t *testing.T) {
+	assert.True(t, math.IsNaN(pie.HarmonicMean([]int{2, -8})))
+}
@@ -0,0 +1,82 @@
+package pie
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// HistogramBin is a single bin of a histogram. Each bin includes Min but not
+// Max, except for the last bin, which includes both.
+type HistogramBin struct {
+	Min, Max float64
+	Count    int
+}
+
+// Histogram counts the elements in bins of equal width between the smallest
+// and largest element. NaN and infinite values are not counted.
+//
+// If all of the elements are the same value, the bins cover the range of 0.5
+// either side of the value. nil is returned if there are no (finite) elements.
+// It will panic if bins is less than 1.
+//
+// See HistogramEdges and HistogramQuantiles for other ways to choose the bins.
+func Histogram[T constraints.Integer | constraints.Float](ss []T, bins int) []HistogramBin {
+	if bins <= 0 {
+		panic("bins should be greater than 0")
+	}
+
+	values := finiteFloat64s(ss)
+	if len(values) == 0 {
+		return nil
+	}
+
+	min, max := values[0], values[0]
+	for _, value := range values[1:] {
+		min = math.Min(min, value)
+		max = math.Max(max, value)
+	}
+
+	if min == max {
+		min, max = min-0.5, max+0.5
+	}
+
+	edges := make([]float64, bins+1)
+	for i := range edges {
+		edges[i] = interpolate(min, max, float64(i)/float64(bins))
+
+		// Rounding must not make the edges unsorted.
+		if i > 0 {
+			edges[i] = math.Max(edges[i], edges[i-1])
+		}
+	}
+
+	// Make sure the largest element is not lost to rounding.
+	edges[bins] = max
+
+	return HistogramEdges(values, edges)
+}
+
+// interpolate returns the value t (from 0 to 1) of the way from a to b. b-a
+// overflows when a and b are large with opposite signs, so a slightly less
+// accurate formula is used instead.
+func interpolate(a, b, t float64) float64 {
+	if d := b - a; !math.IsInf(d, 0) {
+		return a + d*t
+	}
+
+	return a*(1-t) + b*t
+}
+
+// finiteFloat64s returns the elements that are not NaN or infinite, as
+// float64s.
+func finiteFloat64s[T constraints.Integer | constraints.Float](ss []T) []float64 {
+	values := make([]float64, 0, len(ss))
+	for _, s := range ss {
+		if value := float64(s); !math.IsNaN(value) && !math.IsInf(value, 0) {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
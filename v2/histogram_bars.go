@@ -0,0 +1,67 @@
+package pie
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// HistogramBars renders bins (from Histogram, HistogramEdges or
+// HistogramQuantiles) as a horizontal bar chart in plain ASCII, with one line
+// for each bin:
+//
+//	[0, 5)   ########## 10
+//	[5, 10)  #####       5
+//	[10, 15]             0
+//
+// The longest bar is width characters and the others are scaled to match. Any
+// bin with a count greater than zero has a bar of at least one character. It
+// will panic if width is less than 1.
+func HistogramBars(bins []HistogramBin, width int) string {
+	if width <= 0 {
+		panic("width should be greater than 0")
+	}
+
+	labels := make([]string, len(bins))
+	counts := make([]string, len(bins))
+	labelWidth, countWidth, maxCount := 0, 0, 0
+	for i, bin := range bins {
+		end := ")"
+		if i == len(bins)-1 {
+			end = "]"
+		}
+
+		labels[i] = "[" + formatEdge(bin.Min) + ", " + formatEdge(bin.Max) + end
+		counts[i] = strconv.Itoa(bin.Count)
+
+		if len(labels[i]) > labelWidth {
+			labelWidth = len(labels[i])
+		}
+
+		if len(counts[i]) > countWidth {
+			countWidth = len(counts[i])
+		}
+
+		if bin.Count > maxCount {
+			maxCount = bin.Count
+		}
+	}
+
+	var sb strings.Builder
+	for i, bin := range bins {
+		bar := 0
+		if bin.Count > 0 {
+			bar = int(math.Max(1, math.Round(float64(bin.Count)/float64(maxCount)*float64(width))))
+		}
+
+		fmt.Fprintf(&sb, "%-*s %s%s %*s\n", labelWidth, labels[i],
+			strings.Repeat("#", bar), strings.Repeat(" ", width-bar), countWidth, counts[i])
+	}
+
+	return sb.String()
+}
+
+func formatEdge(edge float64) string {
+	return strconv.FormatFloat(edge, 'g', 6, 64)
+}
@@ -0,0 +1,25 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogramBars(t *testing.T) {
+	assert.Equal(t, "", pie.HistogramBars(nil, 10))
+
+	assert.Equal(t, `[0, 5)   ########## 10
+[5, 10)  #####       5
+[10, 15]             0
+`, pie.HistogramBars([]pie.HistogramBin{{0, 5, 10}, {5, 10, 5}, {10, 15, 0}}, 10))
+
+	assert.Equal(t, `[0.5, 1) #      1
+[1, 1.5] ### 1000
+`, pie.HistogramBars([]pie.HistogramBin{{0.5, 1, 1}, {1, 1.5, 1000}}, 3))
+
+	assert.PanicsWithValue(t, "width should be greater than 0", func() {
+		pie.HistogramBars(nil, 0)
+	})
+}
@@ -0,0 +1,29 @@
+package pie
+
+import "golang.org/x/exp/constraints"
+
+// HistogramEdges counts the elements in the bins between each of the edges,
+// so there is one less bin than edges:
+//
+//	HistogramEdges([1, 2, 5, 10, 11], [0, 5, 10]) => [{0, 5, 2}, {5, 10, 2}]
+//
+// Elements outside of the edges (and NaN) are not counted. See Bucketize for
+// how the elements are assigned to bins. It will panic if there are fewer than
+// two edges or the edges are not sorted.
+func HistogramEdges[T constraints.Integer | constraints.Float](ss []T, edges []float64) []HistogramBin {
+	checkEdges(edges)
+
+	bins := make([]HistogramBin, len(edges)-1)
+	for i := range bins {
+		bins[i].Min = edges[i]
+		bins[i].Max = edges[i+1]
+	}
+
+	for _, bucket := range Bucketize(ss, edges) {
+		if bucket >= 0 {
+			bins[bucket].Count++
+		}
+	}
+
+	return bins
+}
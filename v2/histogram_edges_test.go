@@ -0,0 +1,26 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var histogramEdgesTests = []struct {
+	ss       []int
+	edges    []float64
+	expected []pie.HistogramBin
+}{
+	{nil, []float64{0, 1}, []pie.HistogramBin{{0, 1, 0}}},
+	{[]int{1, 2, 5, 10, 11}, []float64{0, 5, 10}, []pie.HistogramBin{{0, 5, 2}, {5, 10, 2}}},
+	{[]int{-1, 0, 1, 2}, []float64{0, 1, 1, 2}, []pie.HistogramBin{{0, 1, 1}, {1, 1, 0}, {1, 2, 2}}},
+}
+
+func TestHistogramEdges(t *testing.T) {
+	for _, test := range histogramEdgesTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.HistogramEdges(test.ss, test.edges))
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package pie
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+	"golang.org/x/exp/slices"
+)
+
+// HistogramQuantiles counts the elements in bins that each contain about the
+// same number of elements. The edges are the quantiles of the elements (using
+// linear interpolation), so the bins are narrower where the elements are
+// closer together.
+//
+// Repeated values can result in bins with a width of zero, which will be empty
+// unless it is the last bin. NaN and infinite values are not counted. nil is
+// returned if there are no (finite) elements. It will panic if bins is less
+// than 1.
+func HistogramQuantiles[T constraints.Integer | constraints.Float](ss []T, bins int) []HistogramBin {
+	if bins <= 0 {
+		panic("bins should be greater than 0")
+	}
+
+	values := finiteFloat64s(ss)
+	if len(values) == 0 {
+		return nil
+	}
+
+	slices.Sort(values)
+
+	edges := make([]float64, bins+1)
+	for i := range edges {
+		position := float64(len(values)-1) * float64(i) / float64(bins)
+		lower := int(math.Floor(position))
+		upper := int(math.Ceil(position))
+		edges[i] = interpolate(values[lower], values[upper], position-float64(lower))
+
+		// Rounding must not make the edges unsorted.
+		if i > 0 {
+			edges[i] = math.Max(edges[i], edges[i-1])
+		}
+	}
+
+	return HistogramEdges(values, edges)
+}
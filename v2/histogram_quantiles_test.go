@@ -0,0 +1,43 @@
+package pie_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var histogramQuantilesTests = []struct {
+	ss       []float64
+	bins     int
+	expected []pie.HistogramBin
+}{
+	{nil, 2, nil},
+	{[]float64{5}, 2, []pie.HistogramBin{{5, 5, 0}, {5, 5, 1}}},
+	{
+		[]float64{100, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		4,
+		[]pie.HistogramBin{{1, 3.25, 3}, {3.25, 5.5, 2}, {5.5, 7.75, 2}, {7.75, 100, 3}},
+	},
+	{
+		[]float64{1, 1, 1, 1, 2},
+		2,
+		[]pie.HistogramBin{{1, 1, 0}, {1, 2, 5}},
+	},
+}
+
+func TestHistogramQuantiles(t *testing.T) {
+	for _, test := range histogramQuantilesTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.HistogramQuantiles(test.ss, test.bins))
+		})
+	}
+}
+
+func TestHistogramQuantilesExtremeValues(t *testing.T) {
+	assert.Equal(t, []pie.HistogramBin{
+		{Min: -math.MaxFloat64, Max: 0, Count: 1},
+		{Min: 0, Max: math.MaxFloat64, Count: 1},
+	}, pie.HistogramQuantiles([]float64{-math.MaxFloat64, math.MaxFloat64}, 2))
+}
@@ -0,0 +1,59 @@
+package pie_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var histogramTests = []struct {
+	ss       []float64
+	bins     int
+	expected []pie.HistogramBin
+}{
+	{nil, 3, nil},
+	{[]float64{math.NaN(), math.Inf(1)}, 3, nil},
+	{[]float64{2}, 2, []pie.HistogramBin{{1.5, 2, 0}, {2, 2.5, 1}}},
+	{
+		[]float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		3,
+		[]pie.HistogramBin{{1, 4, 3}, {4, 7, 3}, {7, 10, 4}},
+	},
+	{
+		[]float64{0.1, 0.2, 0.3, math.NaN(), math.Inf(-1)},
+		1,
+		[]pie.HistogramBin{{0.1, 0.3, 3}},
+	},
+}
+
+func TestHistogram(t *testing.T) {
+	for _, test := range histogramTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.Histogram(test.ss, test.bins))
+		})
+	}
+}
+
+func TestHistogramCountsEveryElement(t *testing.T) {
+	ss := []float64{0.1, 0.7, 0.2, 0.3, 1e-9, 0.9999999}
+	for bins := 1; bins < 20; bins++ {
+		assert.Equal(t, len(ss), pie.Sum(pie.Map(pie.Histogram(ss, bins), func(b pie.HistogramBin) int {
+			return b.Count
+		})))
+	}
+}
+
+func TestHistogramPanics(t *testing.T) {
+	assert.PanicsWithValue(t, "bins should be greater than 0", func() {
+		pie.Histogram([]int{1}, 0)
+	})
+}
+
+func TestHistogramExtremeValues(t *testing.T) {
+	assert.Equal(t, []pie.HistogramBin{
+		{Min: -math.MaxFloat64, Max: 0, Count: 1},
+		{Min: 0, Max: math.MaxFloat64, Count: 2},
+	}, pie.Histogram([]float64{-math.MaxFloat64, math.MaxFloat64, 1}, 2))
+}
@@ -0,0 +1,23 @@
+package inplace
+
+// Dedup replaces consecutive runs of equal elements with the first element of
+// each run, and returns the shortened slice. Sort the slice first to remove all
+// duplicates. The elements after the new length are set to their zero value so
+// that they can be garbage collected.
+func Dedup[T comparable](ss []T) []T {
+	if len(ss) < 2 {
+		return ss
+	}
+
+	n := 1
+	for i := 1; i < len(ss); i++ {
+		if ss[i] != ss[n-1] {
+			ss[n] = ss[i]
+			n++
+		}
+	}
+
+	zero(ss[n:])
+
+	return ss[:n]
+}
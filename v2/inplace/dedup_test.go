@@ -0,0 +1,43 @@
+package inplace_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2/inplace"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/exp/slices"
+)
+
+var dedupTests = []struct {
+	ss       []string
+	expected []string
+}{
+	{nil, nil},
+	{[]string{}, []string{}},
+	{[]string{"a"}, []string{"a"}},
+	{[]string{"a", "a", "a"}, []string{"a"}},
+	{[]string{"a", "b", "b", "a", "c", "c"}, []string{"a", "b", "a", "c"}},
+}
+
+func TestDedup(t *testing.T) {
+	for _, test := range dedupTests {
+		t.Run("", func(t *testing.T) {
+			ss := slices.Clone(test.ss)
+
+			deduped := inplace.Dedup(ss)
+			assert.Equal(t, test.expected, deduped)
+
+			// The removed elements are cleared.
+			for _, s := range ss[len(deduped):] {
+				assert.Zero(t, s)
+			}
+		})
+	}
+}
+
+func TestDedupDoesNotAllocate(t *testing.T) {
+	ss := []string{"a", "a", "b", "c", "c"}
+	assert.Zero(t, testing.AllocsPerRun(100, func() {
+		inplace.Dedup(ss)
+	}))
+}
@@ -0,0 +1,8 @@
+// Package inplace has versions of some of the pie functions that modify the
+// slice they are given instead of returning a new slice. They do not allocate,
+// which makes them useful in hot paths where the input is not needed
+// afterwards.
+//
+// Functions that change the length of the slice (such as Filter) return the
+// shortened slice. The input slice should not be used after that.
+package inplace
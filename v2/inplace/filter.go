@@ -0,0 +1,25 @@
+package inplace
+
+// Filter keeps the elements where condition returns true, in their original
+// order, and returns the shortened slice. The elements after the new length are
+// set to their zero value so that they can be garbage collected.
+func Filter[T any](ss []T, condition func(T) bool) []T {
+	n := 0
+	for _, s := range ss {
+		if condition(s) {
+			ss[n] = s
+			n++
+		}
+	}
+
+	zero(ss[n:])
+
+	return ss[:n]
+}
+
+func zero[T any](ss []T) {
+	var zeroValue T
+	for i := range ss {
+		ss[i] = zeroValue
+	}
+}
@@ -0,0 +1,46 @@
+package inplace_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2/inplace"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/exp/slices"
+)
+
+func isEven(i int) bool {
+	return i%2 == 0
+}
+
+var filterTests = []struct {
+	ss       []int
+	expected []int
+}{
+	{nil, []int{}},
+	{[]int{}, []int{}},
+	{[]int{1, 3}, []int{}},
+	{[]int{2, 4}, []int{2, 4}},
+	{[]int{1, 2, 3, 4, 5, 6}, []int{2, 4, 6}},
+}
+
+func TestFilter(t *testing.T) {
+	for _, test := range filterTests {
+		t.Run("", func(t *testing.T) {
+			ss := slices.Clone(test.ss)
+			filtered := inplace.Filter(ss, isEven)
+			assert.Equal(t, test.expected, append([]int{}, filtered...))
+
+			// The removed elements are cleared.
+			for _, s := range ss[len(filtered):] {
+				assert.Zero(t, s)
+			}
+		})
+	}
+}
+
+func TestFilterDoesNotAllocate(t *testing.T) {
+	ss := []int{1, 2, 3, 4, 5, 6}
+	assert.Zero(t, testing.AllocsPerRun(100, func() {
+		inplace.Filter(ss, isEven)
+	}))
+}
@@ -0,0 +1,8 @@
+package inplace
+
+// Reverse reverses the order of the elements.
+func Reverse[T any](ss []T) {
+	for i, j := 0, len(ss)-1; i < j; i, j = i+1, j-1 {
+		ss[i], ss[j] = ss[j], ss[i]
+	}
+}
@@ -0,0 +1,36 @@
+package inplace_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2/inplace"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/exp/slices"
+)
+
+var reverseTests = []struct {
+	ss       []int
+	expected []int
+}{
+	{nil, nil},
+	{[]int{1}, []int{1}},
+	{[]int{1, 2}, []int{2, 1}},
+	{[]int{1, 2, 3}, []int{3, 2, 1}},
+}
+
+func TestReverse(t *testing.T) {
+	for _, test := range reverseTests {
+		t.Run("", func(t *testing.T) {
+			ss := slices.Clone(test.ss)
+			inplace.Reverse(ss)
+			assert.Equal(t, test.expected, ss)
+		})
+	}
+}
+
+func TestReverseDoesNotAllocate(t *testing.T) {
+	ss := []int{1, 2, 3, 4, 5}
+	assert.Zero(t, testing.AllocsPerRun(100, func() {
+		inplace.Reverse(ss)
+	}))
+}
@@ -0,0 +1,17 @@
+package inplace
+
+import (
+	"math/rand"
+)
+
+// Shuffle randomizes the order of the elements using source. For the same
+// source it produces the same order as pie.Shuffle.
+func Shuffle[T any](ss []T, source rand.Source) {
+	if len(ss) < 2 {
+		return
+	}
+
+	rand.New(source).Shuffle(len(ss), func(i, j int) {
+		ss[i], ss[j] = ss[j], ss[i]
+	})
+}
@@ -0,0 +1,32 @@
+package inplace_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/elliotchance/pie/v2/inplace"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShuffle(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		inplace.Shuffle([]int(nil), nil)
+	})
+
+	t.Run("same as pie.Shuffle", func(t *testing.T) {
+		ss := []int{1, 2, 3, 4, 5, 6, 7, 8}
+		expected := pie.Shuffle(ss, rand.NewSource(0))
+
+		inplace.Shuffle(ss, rand.NewSource(0))
+		assert.Equal(t, expected, ss)
+	})
+}
+
+func TestShuffleDoesNotAllocate(t *testing.T) {
+	ss := []int{1, 2, 3, 4, 5}
+	source := rand.NewSource(0)
+	assert.Zero(t, testing.AllocsPerRun(100, func() {
+		inplace.Shuffle(ss, source)
+	}))
+}
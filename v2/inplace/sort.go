@@ -0,0 +1,11 @@
+package inplace
+
+import (
+	"golang.org/x/exp/constraints"
+	"golang.org/x/exp/slices"
+)
+
+// Sort sorts the elements in ascending order.
+func Sort[T constraints.Ordered](ss []T) {
+	slices.Sort(ss)
+}
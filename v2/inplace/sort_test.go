@@ -0,0 +1,35 @@
+package inplace_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2/inplace"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/exp/slices"
+)
+
+var sortTests = []struct {
+	ss       []float64
+	expected []float64
+}{
+	{nil, nil},
+	{[]float64{1.5}, []float64{1.5}},
+	{[]float64{3, 1.5, 2, 1.5}, []float64{1.5, 1.5, 2, 3}},
+}
+
+func TestSort(t *testing.T) {
+	for _, test := range sortTests {
+		t.Run("", func(t *testing.T) {
+			ss := slices.Clone(test.ss)
+			inplace.Sort(ss)
+			assert.Equal(t, test.expected, ss)
+		})
+	}
+}
+
+func TestSortDoesNotAllocate(t *testing.T) {
+	ss := []float64{5, 3, 1, 4, 2}
+	assert.Zero(t, testing.AllocsPerRun(100, func() {
+		inplace.Sort(ss)
+	}))
+}
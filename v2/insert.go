@@ -1,10 +1,19 @@
 package pie
 
-// Insert a value at an index.
+// Insert a value at an index. If the index is greater than or equal to the
+// length of the slice, the values are appended to the end.
 func Insert[T any](ss []T, index int, values ...T) []T {
-	if index >= len(ss) {
-		return append(ss, values...)
+	if ss == nil && len(values) == 0 {
+		return nil
 	}
 
-	return append(ss[:index], append(values, ss[index:]...)...)
+	if index > len(ss) {
+		index = len(ss)
+	}
+
+	inserted := make([]T, 0, len(ss)+len(values))
+	inserted = append(inserted, ss[:index]...)
+	inserted = append(inserted, values...)
+
+	return append(inserted, ss[index:]...)
 }
@@ -0,0 +1,463 @@
+// Package ofgen generates the chaining wrappers (OfSlice, OfComparableSlice,
+// OfOrderedSlice and OfNumericSlice) from the free functions of the pie
+// package.
+//
+// The pie package is type checked with go/types to find every function that
+// accepts a slice of its first type parameter. The constraint of that type
+// parameter determines which wrappers the function is added to. For example,
+// a function constrained by comparable is added to OfComparableSlice,
+// OfOrderedSlice and OfNumericSlice, but not OfSlice.
+package ofgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// Level is how restrictive the constraint of a function or wrapper is. Each
+// level allows a subset of the element types of the level before it.
+type Level int
+
+const (
+	LevelAny Level = iota
+	LevelComparable
+	LevelOrdered
+	LevelNumeric
+)
+
+// Wrapper describes one of the generated chaining types.
+type Wrapper struct {
+	// File is the name of the generated file.
+	File string
+
+	// Constructor and Type are the names of the function that creates the
+	// wrapper and the type that it returns.
+	Constructor, Type string
+
+	// Constraint is the Go source for the type parameter constraint.
+	Constraint string
+
+	// Level is the most restrictive constraint of the functions that can be
+	// added to this wrapper.
+	Level Level
+
+	// ConstructorDoc and TypeDoc are the doc comments for the constructor and
+	// type, without the comment markers.
+	ConstructorDoc, TypeDoc string
+}
+
+// Accepts returns true if fn can be added to the wrapper.
+func (w Wrapper) Accepts(fn Function) bool {
+	return fn.Level <= w.Level
+}
+
+// Wrappers are all of the generated wrappers.
+var Wrappers = []Wrapper{
+	{
+		File:           "of.go",
+		Constructor:    "Of",
+		Type:           "OfSlice",
+		Constraint:     "any",
+		Level:          LevelAny,
+		ConstructorDoc: "Of encapsulates a slice to be used in multiple chained operations.\n",
+		TypeDoc: "OfSlice provides the proxy methods that operate on slices. If the last method\n" +
+			"in the chain does not return a single value, you can access the Result to get\n" +
+			"final slice.\n",
+	},
+	{
+		File:        "of_comparable.go",
+		Constructor: "OfComparable",
+		Type:        "OfComparableSlice",
+		Constraint:  "comparable",
+		Level:       LevelComparable,
+		ConstructorDoc: "OfComparable encapsulates a slice to be used in multiple chained operations.\n" +
+			"OfComparable requires that elements be comparable, such as structs without\n" +
+			"slice, map or function fields, for certain operations to be performed.\n",
+		TypeDoc: "OfComparableSlice provides the proxy methods that operate on slices. If the\n" +
+			"last method in the chain does not return a single value, you can access the\n" +
+			"Result to get final slice.\n",
+	},
+	{
+		File:        "of_ordered.go",
+		Constructor: "OfOrdered",
+		Type:        "OfOrderedSlice",
+		Constraint:  "constraints.Ordered",
+		Level:       LevelOrdered,
+		ConstructorDoc: "OfOrdered encapsulates a slice to be used in multiple chained operations.\n" +
+			"OfOrdered requires that elements be numerical or a string for certain\n" +
+			"operations to be performed.\n",
+		TypeDoc: "OfOrderedSlice provides the proxy methods that operate on slices. If the last\n" +
+			"method in the chain does not return a single value, you can access the Result\n" +
+			"to get final slice.\n",
+	},
+	{
+		File:        "of_numeric.go",
+		Constructor: "OfNumeric",
+		Type:        "OfNumericSlice",
+		Constraint:  "constraints.Integer | constraints.Float",
+		Level:       LevelNumeric,
+		ConstructorDoc: "OfNumeric encapsulates a slice to be used in multiple chained operations.\n" +
+			"OfNumeric requires that elements be numerical for certain operations to be\n" +
+			"performed.\n",
+		TypeDoc: "OfNumericSlice provides the proxy methods that operate on slices. If the last\n" +
+			"method in the chain does not return a single value, you can access the Result\n" +
+			"to get final slice.\n",
+	},
+}
+
+// TestFile is the name of the generated test that fails when a function is
+// missing from a wrapper.
+const TestFile = "of_generated_test.go"
+
+// Function is a free function that can be added to a wrapper.
+type Function struct {
+	Name  string
+	Doc   string
+	Level Level
+
+	// Params and Args are the method parameters and the arguments passed to
+	// the function, including o.Result in the position of the slice.
+	Params, Args []string
+
+	// Results are the result types. If Chain is true the function returns
+	// []T and the method returns the wrapper instead.
+	Results []string
+	Chain   bool
+
+	// Pointer is true if the function accepts a pointer to the slice, such as
+	// Pop. The method will have a pointer receiver.
+	Pointer bool
+
+	// Imports are the import paths needed by the parameters and results.
+	Imports []string
+}
+
+func isWrapperFile(name string) bool {
+	for _, w := range Wrappers {
+		if name == w.File {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Load type checks the pie package in dir and returns all of the functions
+// that can be added to at least one wrapper, sorted by name.
+//
+// The generated wrapper files are not loaded so that stale or missing wrappers
+// do not prevent generating new ones.
+func Load(dir string) ([]Function, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && !isWrapperFile(info.Name())
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, ok := pkgs["pie"]
+	if !ok {
+		return nil, fmt.Errorf("no pie package in %s", dir)
+	}
+
+	var files []*ast.File
+	for _, file := range pkg.Files {
+		files = append(files, file)
+	}
+
+	info := &types.Info{
+		Defs: map[*ast.Ident]types.Object{},
+		Uses: map[*ast.Ident]types.Object{},
+	}
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	if _, err := conf.Check("github.com/elliotchance/pie/v2", fset, files, info); err != nil {
+		return nil, err
+	}
+
+	var functions []Function
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv != nil || !fd.Name.IsExported() {
+				continue
+			}
+
+			if fn, ok := newFunction(fset, info, fd); ok {
+				functions = append(functions, fn)
+			}
+		}
+	}
+
+	sort.Slice(functions, func(i, j int) bool {
+		return functions[i].Name < functions[j].Name
+	})
+
+	return functions, nil
+}
+
+// newFunction returns false if fd cannot be a method. It must accept a slice
+// (or a pointer to a slice) of its first type parameter. Any other type
+// parameters must be unconstrained, they are all replaced with T. This allows
+// Map to be used as a method, although it cannot change the element type.
+//
+// The Append functions are never methods because a chain cannot reuse the
+// destination slice.
+func newFunction(fset *token.FileSet, info *types.Info, fd *ast.FuncDecl) (Function, bool) {
+	if strings.HasPrefix(fd.Name.Name, "Append") {
+		return Function{}, false
+	}
+
+	sig := info.Defs[fd.Name].Type().(*types.Signature)
+	if sig.TypeParams().Len() == 0 {
+		return Function{}, false
+	}
+
+	tp := sig.TypeParams().At(0)
+	typeParams := map[string]struct{}{}
+	for i := 0; i < sig.TypeParams().Len(); i++ {
+		p := sig.TypeParams().At(i)
+		if level, _ := constraintLevel(p); i > 0 && level != LevelAny {
+			return Function{}, false
+		}
+
+		typeParams[p.Obj().Name()] = struct{}{}
+	}
+
+	isSlice := func(typ types.Type) bool {
+		if slice, ok := typ.(*types.Slice); ok {
+			if elem, ok := slice.Elem().(*types.TypeParam); ok {
+				_, ok := typeParams[elem.Obj().Name()]
+				return ok
+			}
+		}
+
+		return false
+	}
+
+	sliceParam, pointer := -1, false
+	for i := 0; i < sig.Params().Len(); i++ {
+		typ := sig.Params().At(i).Type()
+		if types.Identical(typ, types.NewSlice(tp)) {
+			sliceParam = i
+			break
+		}
+
+		if types.Identical(typ, types.NewPointer(types.NewSlice(tp))) {
+			sliceParam, pointer = i, true
+			break
+		}
+	}
+
+	if sliceParam < 0 {
+		return Function{}, false
+	}
+
+	level, ok := constraintLevel(tp)
+	if !ok {
+		return Function{}, false
+	}
+
+	fn := Function{
+		Name:    fd.Name.Name,
+		Doc:     fd.Doc.Text(),
+		Level:   level,
+		Pointer: pointer,
+	}
+
+	imports := map[string]struct{}{}
+	render := func(expr ast.Expr) string {
+		// Method type parameters are always called T.
+		ast.Inspect(expr, func(node ast.Node) bool {
+			switch n := node.(type) {
+			case *ast.Ident:
+				if _, ok := typeParams[n.Name]; ok {
+					n.Name = "T"
+				}
+
+			case *ast.SelectorExpr:
+				if x, ok := n.X.(*ast.Ident); ok {
+					if pkgName, ok := info.Uses[x].(*types.PkgName); ok {
+						imports[pkgName.Imported().Path()] = struct{}{}
+					}
+				}
+			}
+
+			return true
+		})
+
+		var buf bytes.Buffer
+		_ = printer.Fprint(&buf, fset, expr)
+
+		return buf.String()
+	}
+
+	i := 0
+	for _, field := range fd.Type.Params.List {
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("arg%d", i))}
+		}
+
+		typ := render(field.Type)
+		var params []string
+		for _, name := range names {
+			switch {
+			case i == sliceParam && pointer:
+				fn.Args = append(fn.Args, "&o.Result")
+
+			case i == sliceParam:
+				fn.Args = append(fn.Args, "o.Result")
+
+			case strings.HasPrefix(typ, "..."):
+				params = append(params, name.Name)
+				fn.Args = append(fn.Args, name.Name+"...")
+
+			default:
+				params = append(params, name.Name)
+				fn.Args = append(fn.Args, name.Name)
+			}
+			i++
+		}
+
+		if len(params) > 0 {
+			fn.Params = append(fn.Params, strings.Join(params, ", ")+" "+typ)
+		}
+	}
+
+	results := sig.Results()
+	fn.Chain = !pointer && results.Len() == 1 && isSlice(results.At(0).Type())
+
+	if fd.Type.Results != nil && !fn.Chain {
+		for _, field := range fd.Type.Results.List {
+			typ := render(field.Type)
+			for j := 0; j < len(field.Names) || j == 0; j++ {
+				fn.Results = append(fn.Results, typ)
+			}
+		}
+	}
+
+	for path := range imports {
+		fn.Imports = append(fn.Imports, path)
+	}
+
+	return fn, true
+}
+
+// constraintLevel checks which representative types satisfy the constraint
+// of tp. false is returned if the constraint does not fit any of the levels.
+func constraintLevel(tp *types.TypeParam) (Level, bool) {
+	iface, ok := tp.Constraint().Underlying().(*types.Interface)
+	if !ok {
+		return 0, false
+	}
+
+	satisfies := func(typ types.Type) bool {
+		return types.Satisfies(typ, iface)
+	}
+
+	switch {
+	case satisfies(types.NewSignatureType(nil, nil, nil, nil, nil, false)):
+		return LevelAny, true
+
+	case satisfies(types.NewStruct(nil, nil)):
+		return LevelComparable, true
+
+	case satisfies(types.Typ[types.String]):
+		return LevelOrdered, true
+
+	case satisfies(types.Typ[types.Int]) && satisfies(types.Typ[types.Float64]):
+		return LevelNumeric, true
+	}
+
+	return 0, false
+}
+
+// Generate returns the formatted source of each of the wrapper files and the
+// test file, keyed by file name.
+func Generate(dir string) (map[string][]byte, error) {
+	functions, err := Load(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string][]byte{}
+	for _, w := range Wrappers {
+		var methods []Function
+		imports := map[string]struct{}{}
+		if strings.Contains(w.Constraint, "constraints.") {
+			imports["golang.org/x/exp/constraints"] = struct{}{}
+		}
+
+		for _, fn := range functions {
+			if w.Accepts(fn) {
+				methods = append(methods, fn)
+				for _, path := range fn.Imports {
+					imports[path] = struct{}{}
+				}
+			}
+		}
+
+		files[w.File], err = execute(wrapperTemplate, map[string]interface{}{
+			"Wrapper": w,
+			"Methods": methods,
+			"Imports": sortedKeys(imports),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	files[TestFile], err = execute(testTemplate, Wrappers)
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// Write generates all of the files and writes them to dir.
+func Write(dir string) error {
+	files, err := Generate(dir)
+	if err != nil {
+		return err
+	}
+
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func execute(tmpl *template.Template, data interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+func sortedKeys(m map[string]struct{}) (keys []string) {
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return
+}
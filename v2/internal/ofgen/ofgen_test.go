@@ -0,0 +1,106 @@
+package ofgen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const dir = "../.."
+
+func TestGenerateIsUpToDate(t *testing.T) {
+	files, err := Generate(dir)
+	require.NoError(t, err)
+
+	for name, data := range files {
+		existing, err := os.ReadFile(filepath.Join(dir, name))
+		require.NoError(t, err)
+		assert.Equal(t, string(data), string(existing), "%s is out of date, run go generate", name)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	functions, err := Load(dir)
+	require.NoError(t, err)
+
+	byName := map[string]Function{}
+	for _, fn := range functions {
+		byName[fn.Name] = fn
+	}
+
+	for name, expected := range map[string]Function{
+		"Filter": {
+			Level:  LevelAny,
+			Params: []string{"condition func(T) bool"},
+			Args:   []string{"o.Result", "condition"},
+			Chain:  true,
+		},
+		"Contains": {
+			Level:   LevelComparable,
+			Params:  []string{"lookingFor T"},
+			Args:    []string{"o.Result", "lookingFor"},
+			Results: []string{"bool"},
+		},
+		"JSONStringIndent": {
+			Level:   LevelOrdered,
+			Params:  []string{"prefix, indent string"},
+			Args:    []string{"o.Result", "prefix", "indent"},
+			Results: []string{"string"},
+		},
+		"Diff": {
+			Level:   LevelComparable,
+			Params:  []string{"against []T"},
+			Args:    []string{"o.Result", "against"},
+			Results: []string{"[]T", "[]T"},
+		},
+		"Send": {
+			Level:   LevelAny,
+			Params:  []string{"ctx context.Context", "ch chan<- T"},
+			Args:    []string{"ctx", "o.Result", "ch"},
+			Chain:   true,
+			Imports: []string{"context"},
+		},
+		"Delete": {
+			Level:  LevelAny,
+			Params: []string{"idx ...int"},
+			Args:   []string{"o.Result", "idx..."},
+			Chain:  true,
+		},
+		"Map": {
+			Level:  LevelAny,
+			Params: []string{"fn func(T) T"},
+			Args:   []string{"o.Result", "fn"},
+			Chain:  true,
+		},
+		"Pop": {
+			Level:   LevelAny,
+			Args:    []string{"&o.Result"},
+			Results: []string{"*T"},
+			Pointer: true,
+		},
+		"Sum": {
+			Level:   LevelNumeric,
+			Args:    []string{"o.Result"},
+			Results: []string{"T"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			fn, ok := byName[name]
+			require.True(t, ok)
+
+			expected.Name = name
+			expected.Doc = fn.Doc
+			assert.Equal(t, expected, fn)
+		})
+	}
+
+	// Functions that do not accept a slice of their type parameter, or have
+	// constrained extra type parameters, cannot be methods.
+	for _, name := range []string{"Abs", "Flat", "GroupBy", "Keys", "Then"} {
+		_, ok := byName[name]
+		assert.False(t, ok, name)
+	}
+}
@@ -0,0 +1,111 @@
+package ofgen
+
+import (
+	"strings"
+	"text/template"
+)
+
+var funcs = template.FuncMap{
+	"comment": func(doc string) string {
+		doc = strings.TrimRight(doc, "\n")
+		if doc == "" {
+			return ""
+		}
+
+		lines := strings.Split(doc, "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight("// "+line, " ")
+		}
+
+		return strings.Join(lines, "\n") + "\n"
+	},
+	"join": strings.Join,
+	"results": func(results []string) string {
+		if len(results) > 1 {
+			return "(" + strings.Join(results, ", ") + ")"
+		}
+
+		return strings.Join(results, "")
+	},
+}
+
+var wrapperTemplate = template.Must(template.New("wrapper").Funcs(funcs).Parse(
+	`// Code generated by go generate; DO NOT EDIT.
+
+package pie
+
+{{ with .Imports }}import (
+{{ range . }}	"{{ . }}"
+{{ end }})
+{{ end }}
+{{ with .Wrapper }}
+{{- comment .ConstructorDoc -}}
+func {{ .Constructor }}[T {{ .Constraint }}](ss []T) {{ .Type }}[T] {
+	return {{ .Type }}[T]{ss}
+}
+
+{{ comment .TypeDoc -}}
+type {{ .Type }}[T {{ .Constraint }}] struct {
+	Result []T
+}
+{{ end }}
+{{- $type := .Wrapper.Type }}
+{{- range .Methods }}
+
+{{ comment .Doc -}}
+func (o {{ if .Pointer }}*{{ end }}{{ $type }}[T]) {{ .Name }}({{ join .Params ", " }})
+{{- if .Chain }} {{ $type }}[T] {
+	return {{ $type }}[T]{ {{- .Name }}({{ join .Args ", " }})}
+}
+{{- else if .Results }} {{ results .Results }} {
+	return {{ .Name }}({{ join .Args ", " }})
+}
+{{- else }} {
+	{{ .Name }}({{ join .Args ", " }})
+}
+{{- end }}
+{{- end }}
+`))
+
+var testTemplate = template.Must(template.New("test").Funcs(funcs).Parse(
+	`// Code generated by go generate; DO NOT EDIT.
+
+package pie_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/elliotchance/pie/v2/internal/ofgen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOfWrappersHaveAllFunctions(t *testing.T) {
+	functions, err := ofgen.Load(".")
+	require.NoError(t, err)
+
+	// Pointer types are used so that methods with pointer receivers, such as
+	// Pop, are included.
+	wrappers := map[string]reflect.Type{
+{{- range . }}
+		"{{ .Type }}": reflect.TypeOf(&pie.{{ .Type }}[int]{}),
+{{- end }}
+	}
+
+	for _, wrapper := range ofgen.Wrappers {
+		typ := wrappers[wrapper.Type]
+		require.NotNil(t, typ, wrapper.Type)
+
+		for _, fn := range functions {
+			if !wrapper.Accepts(fn) {
+				continue
+			}
+
+			_, ok := typ.MethodByName(fn.Name)
+			assert.True(t, ok, "%s is missing %s, run go generate", wrapper.Type, fn.Name)
+		}
+	}
+}
+`))
@@ -9,6 +9,9 @@ import (
 //
 // One important thing to note is that it will treat a nil slice as an empty
 // slice to ensure that the JSON value return is always an array.
+//
+// The error from the encoder is ignored (nil is returned for a NaN or infinite
+// float). Use JSONMarshal to encode other element types or to check for errors.
 func JSONBytes[T constraints.Ordered](ss []T) []byte {
 	if ss == nil {
 		return []byte("[]")
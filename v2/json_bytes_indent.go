@@ -10,6 +10,10 @@ import (
 // One important thing to note is that it will treat a nil slice as an empty
 // slice to ensure that the JSON value return is always an array. See
 // json.MarshalIndent for details.
+//
+// The error from the encoder is ignored (nil is returned for a NaN or infinite
+// float). Use JSONMarshalIndent to encode other element types or to check for
+// errors.
 func JSONBytesIndent[T constraints.Ordered](ss []T, prefix, indent string) []byte {
 	if ss == nil {
 		return []byte("[]")
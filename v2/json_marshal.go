@@ -0,0 +1,16 @@
+package pie
+
+import "encoding/json"
+
+// JSONMarshal returns the JSON encoded array. Unlike JSONBytes, it works with
+// any element type (such as structs and pointers) and returns the error from
+// the encoder, for example for a NaN float or a failing json.Marshaler.
+//
+// Like JSONBytes, a nil slice is encoded as an empty array rather than null.
+func JSONMarshal[T any](ss []T) ([]byte, error) {
+	if ss == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal(ss)
+}
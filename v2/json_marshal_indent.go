@@ -0,0 +1,13 @@
+package pie
+
+import "encoding/json"
+
+// JSONMarshalIndent works like JSONMarshal, but applies prefix and indent in
+// the same way as json.MarshalIndent.
+func JSONMarshalIndent[T any](ss []T, prefix, indent string) ([]byte, error) {
+	if ss == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.MarshalIndent(ss, prefix, indent)
+}
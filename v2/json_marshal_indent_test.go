@@ -0,0 +1,27 @@
+package pie_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONMarshalIndent(t *testing.T) {
+	data, err := pie.JSONMarshalIndent([]jsonPerson(nil), "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, `[]`, string(data))
+
+	data, err = pie.JSONMarshalIndent([]jsonPerson{{"Bob", 23}}, "", "  ")
+	assert.NoError(t, err)
+	assert.Equal(t, `[
+  {
+    "name": "Bob",
+    "age": 23
+  }
+]`, string(data))
+
+	_, err = pie.JSONMarshalIndent([]float64{math.Inf(1)}, "", "  ")
+	assert.Error(t, err)
+}
@@ -0,0 +1,48 @@
+package pie_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type jsonPerson struct {
+	Name string `json:"name"`
+	Age  int    `json:"age,omitempty"`
+}
+
+type failingMarshaler struct{}
+
+func (failingMarshaler) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("failed")
+}
+
+var jsonMarshalTests = []struct {
+	ss       []*jsonPerson
+	expected string
+}{
+	{nil, `[]`},
+	{[]*jsonPerson{}, `[]`},
+	{[]*jsonPerson{{"Bob", 23}, nil, {Name: "Jane"}}, `[{"name":"Bob","age":23},null,{"name":"Jane"}]`},
+}
+
+func TestJSONMarshal(t *testing.T) {
+	for _, test := range jsonMarshalTests {
+		t.Run("", func(t *testing.T) {
+			data, err := pie.JSONMarshal(test.ss)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, string(data))
+		})
+	}
+}
+
+func TestJSONMarshalError(t *testing.T) {
+	_, err := pie.JSONMarshal([]float64{1, math.NaN()})
+	assert.Error(t, err)
+
+	_, err = pie.JSONMarshal([]failingMarshaler{{}})
+	assert.ErrorContains(t, err, "failed")
+}
@@ -0,0 +1,57 @@
+package pie
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// LinearFit is the result of LinearRegression, the line y = Slope*x + Intercept.
+//
+// RSquared (the coefficient of determination) is how much of the variation in
+// y is explained by the line, from 0 (none) to 1 (all of the points are on the
+// line).
+type LinearFit struct {
+	Slope, Intercept, RSquared float64
+}
+
+// Predict returns the y value of the line at x.
+func (fit LinearFit) Predict(x float64) float64 {
+	return fit.Slope*x + fit.Intercept
+}
+
+// LinearRegression finds the straight line that best fits the points (xs[i],
+// ys[i]) using ordinary least squares.
+//
+// ErrUnequalLengths is returned if xs and ys are not the same length. If there
+// are no elements or all of the xs are the same, there is no single line, so
+// every value is NaN. If all of the ys are the same, the line is flat and
+// RSquared is 1.
+func LinearRegression[T constraints.Integer | constraints.Float](xs, ys []T) (LinearFit, error) {
+	if err := checkLengths(len(xs), len(ys)); err != nil {
+		return LinearFit{}, err
+	}
+
+	nan := math.NaN()
+	if len(xs) == 0 {
+		return LinearFit{nan, nan, nan}, nil
+	}
+
+	sxy, sxx, syy := sumsOfProducts(xs, ys)
+	if sxx == 0 {
+		return LinearFit{nan, nan, nan}, nil
+	}
+
+	slope := sxy / sxx
+	fit := LinearFit{
+		Slope:     slope,
+		Intercept: mean(ys) - slope*mean(xs),
+		RSquared:  1,
+	}
+
+	if syy != 0 {
+		fit.RSquared = math.Min(1, sxy*sxy/(sxx*syy))
+	}
+
+	return fit, nil
+}
@@ -0,0 +1,52 @@
+package pie_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var linearRegressionTests = []struct {
+	xs, ys   []float64
+	expected pie.LinearFit
+}{
+	{[]float64{1, 2, 3}, []float64{5, 7, 9}, pie.LinearFit{2, 3, 1}},
+	{[]float64{1, 2, 3}, []float64{4, 4, 4}, pie.LinearFit{0, 4, 1}},
+	{[]float64{1, 2, 3, 4}, []float64{1, 3, 2, 4}, pie.LinearFit{0.8, 0.5, 0.64}},
+	{[]float64{0, 10}, []float64{-5, 15}, pie.LinearFit{2, -5, 1}},
+}
+
+func TestLinearRegression(t *testing.T) {
+	for _, test := range linearRegressionTests {
+		t.Run("", func(t *testing.T) {
+			fit, err := pie.LinearRegression(test.xs, test.ys)
+			assert.NoError(t, err)
+			assert.InDelta(t, test.expected.Slope, fit.Slope, 1e-12)
+			assert.InDelta(t, test.expected.Intercept, fit.Intercept, 1e-12)
+			assert.InDelta(t, test.expected.RSquared, fit.RSquared, 1e-12)
+		})
+	}
+}
+
+func TestLinearRegressionNoLine(t *testing.T) {
+	for _, xs := range [][]int{nil, {3}, {3, 3}} {
+		fit, err := pie.LinearRegression(xs, xs)
+		assert.NoError(t, err)
+		assert.True(t, math.IsNaN(fit.Slope))
+		assert.True(t, math.IsNaN(fit.Intercept))
+		assert.True(t, math.IsNaN(fit.RSquared))
+	}
+}
+
+func TestLinearRegressionUnequalLengths(t *testing.T) {
+	_, err := pie.LinearRegression([]int{1, 2}, []int{1})
+	assert.ErrorIs(t, err, pie.ErrUnequalLengths)
+}
+
+func TestLinearFitPredict(t *testing.T) {
+	fit := pie.LinearFit{Slope: 2, Intercept: 3}
+	assert.Equal(t, 3.0, fit.Predict(0))
+	assert.Equal(t, 13.0, fit.Predict(5))
+}
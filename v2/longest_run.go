@@ -0,0 +1,18 @@
+package pie
+
+// LongestRun returns the longest run of consecutive equal elements. If there is
+// more than one run with the longest length, the first one is returned:
+//
+//	LongestRun([a, b, b, c, c]) => {b, 2}
+//
+// The Count is zero if there are no elements.
+func LongestRun[T comparable](ss []T) Run[T] {
+	var longest Run[T]
+	for _, run := range RunLengthEncode(ss) {
+		if run.Count > longest.Count {
+			longest = run
+		}
+	}
+
+	return longest
+}
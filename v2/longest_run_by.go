@@ -0,0 +1,15 @@
+package pie
+
+// LongestRunBy works like LongestRun, except that consecutive elements are
+// equal when they have the same key. The Value is the first element of the
+// run. See RunLengthEncodeBy.
+func LongestRunBy[T any, K comparable](ss []T, key func(T) K) Run[T] {
+	var longest Run[T]
+	for _, run := range RunLengthEncodeBy(ss, key) {
+		if run.Count > longest.Count {
+			longest = run
+		}
+	}
+
+	return longest
+}
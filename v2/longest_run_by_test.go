@@ -0,0 +1,25 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var longestRunByTests = []struct {
+	ss       []int
+	expected pie.Run[int]
+}{
+	{nil, pie.Run[int]{}},
+	{[]int{1, 3, 4, 6, 8, 5}, pie.Run[int]{4, 3}},
+	{[]int{1, 3, 4, 6}, pie.Run[int]{1, 2}},
+}
+
+func TestLongestRunBy(t *testing.T) {
+	for _, test := range longestRunByTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.LongestRunBy(test.ss, isEven))
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var longestRunTests = []struct {
+	ss       []string
+	expected pie.Run[string]
+}{
+	{nil, pie.Run[string]{}},
+	{[]string{"a"}, pie.Run[string]{"a", 1}},
+	{[]string{"a", "b", "b", "c", "c"}, pie.Run[string]{"b", 2}},
+	{[]string{"a", "b", "a", "a", "a", "b", "b"}, pie.Run[string]{"a", 3}},
+}
+
+func TestLongestRun(t *testing.T) {
+	for _, test := range longestRunTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.LongestRun(test.ss))
+		})
+	}
+}
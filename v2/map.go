@@ -6,6 +6,17 @@ package pie
 // Be careful when using this with slices of pointers. If you modify the input
 // value it will affect the original slice. Be sure to return a new allocated
 // object or deep copy the existing one.
+//
+// The element type can be changed by the mapping. Since Go methods cannot
+// introduce type parameters, the Map method of OfSlice (and friends) must
+// return the same type. To continue a chain after changing the type, pass the
+// Result through Map and wrap it again:
+//
+//	ids := pie.OfOrdered(pie.Map(pie.Of(users).Filter(isActive).Result, User.ID)).
+//	    Unique().
+//	    Result
+//
+// See also MapIndexed, FlatMap and FilterMap.
 func Map[T any, U any](ss []T, fn func(T) U) (ss2 []U) {
 	if ss == nil {
 		return nil
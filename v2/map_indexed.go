@@ -0,0 +1,18 @@
+package pie
+
+// MapIndexed is the same as Map except the function also receives the index of
+// each element.
+//
+// The number of elements returned will always be the same as the input.
+func MapIndexed[T any, U any](ss []T, fn func(int, T) U) (ss2 []U) {
+	if ss == nil {
+		return nil
+	}
+
+	ss2 = make([]U, len(ss))
+	for i, s := range ss {
+		ss2[i] = fn(i, s)
+	}
+
+	return
+}
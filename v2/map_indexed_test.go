@@ -0,0 +1,23 @@
+package pie_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapIndexed(t *testing.T) {
+	label := func(i int, s string) string {
+		return fmt.Sprintf("%d:%s", i, s)
+	}
+
+	assert.Nil(t, pie.MapIndexed(nil, label))
+	assert.Equal(t, []string{}, pie.MapIndexed([]string{}, label))
+	assert.Equal(t, []string{"0:a", "1:b", "2:c"}, pie.MapIndexed([]string{"a", "b", "c"}, label))
+
+	assert.Equal(t, []int{10, 21}, pie.MapIndexed([]string{"10", "2000"}, func(i int, s string) int {
+		return len(s)*5 + i
+	}))
+}
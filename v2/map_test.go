@@ -15,3 +15,15 @@ func TestMap(t *testing.T) {
 		})
 	}
 }
+
+func TestMapChangingType(t *testing.T) {
+	lengths := pie.OfOrdered(pie.Map(
+		pie.Of([]string{"Bob", "Sally", "John"}).Reverse().Result,
+		func(s string) int {
+			return len(s)
+		})).
+		Sort().
+		Result
+
+	assert.Equal(t, []int{3, 4, 5}, lengths)
+}
@@ -19,10 +19,6 @@ func Median[T constraints.Integer | constraints.Float](ss []T) T {
 		return ss[0]
 	}
 
-	// This implementation aims at linear time O(n) on average.
-	// It uses the same idea as QuickSort, but makes only 1 recursive
-	// call instead of 2. See also Quickselect.
-
 	work := make([]T, len(ss))
 	copy(work, ss)
 
@@ -31,35 +27,7 @@ func Median[T constraints.Integer | constraints.Float](ss []T) T {
 		limit1, limit2 = n/2-1, n/2+1
 	}
 
-	var rec func(a, b int)
-	rec = func(a, b int) {
-		if b-a <= 1 {
-			return
-		}
-		ipivot := (a + b) / 2
-		pivot := work[ipivot]
-		work[a], work[ipivot] = work[ipivot], work[a]
-		j := a
-		k := b
-		for j+1 < k {
-			if work[j+1] < pivot {
-				work[j+1], work[j] = work[j], work[j+1]
-				j++
-			} else {
-				work[j+1], work[k-1] = work[k-1], work[j+1]
-				k--
-			}
-		}
-		// 1 or 0 recursive calls
-		if j > limit1 {
-			rec(a, j)
-		}
-		if j+1 < limit2 {
-			rec(j+1, b)
-		}
-	}
-
-	rec(0, len(work))
+	quickselect(work, limit1, limit2)
 
 	if n%2 == 1 {
 		return work[n/2]
@@ -0,0 +1,34 @@
+package pie
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// MedianAbsoluteDeviation is the median of the absolute differences between
+// each element and the Median of the elements. It is a measure of how spread
+// out the elements are, like Stddev, but is not affected by a few outliers.
+//
+// Unlike Median, the result is a float64 (even for integers) so the median is
+// not rounded. Multiply the result by 1.4826 to estimate the standard deviation
+// of normally distributed data.
+//
+// Zero is returned if there are no elements.
+func MedianAbsoluteDeviation[T constraints.Integer | constraints.Float](ss []T) float64 {
+	if len(ss) == 0 {
+		return 0
+	}
+
+	work := make([]float64, len(ss))
+	for i, s := range ss {
+		work[i] = float64(s)
+	}
+
+	median := medianFloat64(work)
+	for i := range work {
+		work[i] = math.Abs(work[i] - median)
+	}
+
+	return medianFloat64(work)
+}
@@ -0,0 +1,27 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var medianAbsoluteDeviationTests = []struct {
+	ss       []int
+	expected float64
+}{
+	{nil, 0},
+	{[]int{5}, 0},
+	{[]int{1, 2}, 0.5},
+	{[]int{1, 1, 2, 2, 4, 6, 9}, 1},
+	{[]int{1, 2, 3, 4, 1000}, 1},
+}
+
+func TestMedianAbsoluteDeviation(t *testing.T) {
+	for _, test := range medianAbsoluteDeviationTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.MedianAbsoluteDeviation(test.ss))
+		})
+	}
+}
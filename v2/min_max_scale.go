@@ -0,0 +1,28 @@
+package pie
+
+import "golang.org/x/exp/constraints"
+
+// MinMaxScale scales the elements to the range [0, 1], where the smallest
+// element becomes 0 and the largest becomes 1:
+//
+//	MinMaxScale([10, 15, 20]) => [0, 0.5, 1]
+//
+// If all of the elements are the same (so there is no range to scale), every
+// value is 0. nil is returned if there are no elements in the slice.
+func MinMaxScale[T constraints.Integer | constraints.Float](ss []T) []float64 {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	min, max := float64(Min(ss)), float64(Max(ss))
+	result := make([]float64, len(ss))
+	if min == max {
+		return result
+	}
+
+	for i, s := range ss {
+		result[i] = (float64(s) - min) / (max - min)
+	}
+
+	return result
+}
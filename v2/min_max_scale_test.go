@@ -0,0 +1,27 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var minMaxScaleTests = []struct {
+	ss       []int
+	expected []float64
+}{
+	{nil, nil},
+	{[]int{7}, []float64{0}},
+	{[]int{3, 3, 3}, []float64{0, 0, 0}},
+	{[]int{10, 15, 20}, []float64{0, 0.5, 1}},
+	{[]int{5, -5, 0, 15}, []float64{0.5, 0, 0.25, 1}},
+}
+
+func TestMinMaxScale(t *testing.T) {
+	for _, test := range minMaxScaleTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.MinMaxScale(test.ss))
+		})
+	}
+}
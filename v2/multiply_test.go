@@ -0,0 +1,32 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var multiplyTests = []struct {
+	ss1, ss2 []int
+	expected []int
+}{
+	{nil, nil, nil},
+	{[]int{1, 2, 3}, []int{10, 20, 30}, []int{10, 40, 90}},
+	{[]int{1, 2, 3, 4, 5}, []int{-1, 0, 1, 2, 3}, []int{-1, 0, 3, 8, 15}},
+}
+
+func TestMultiply(t *testing.T) {
+	for _, test := range multiplyTests {
+		t.Run("", func(t *testing.T) {
+			result, err := pie.Multiply(test.ss1, test.ss2)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestMultiplyUnequalLengths(t *testing.T) {
+	_, err := pie.Multiply([]int{1}, nil)
+	assert.ErrorIs(t, err, pie.ErrUnequalLengths)
+}
@@ -0,0 +1,35 @@
+package pie
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Norm returns the Euclidean length (the L2 norm) of ss, which is the square
+// root of the sum of the squares of the elements:
+//
+//	Norm([3, 4]) => 5
+//
+// The squares are added as float64s, so integers cannot overflow. Like Dot,
+// they are added to four separate totals. Zero is returned if there are no
+// elements. See NormalizeL2 to scale a slice to a length of 1.
+func Norm[T constraints.Integer | constraints.Float](ss []T) float64 {
+	var sum0, sum1, sum2, sum3 float64
+	i := 0
+	for ; i+4 <= len(ss); i += 4 {
+		a := ss[i : i+4 : i+4]
+		x0, x1, x2, x3 := float64(a[0]), float64(a[1]), float64(a[2]), float64(a[3])
+		sum0 += x0 * x0
+		sum1 += x1 * x1
+		sum2 += x2 * x2
+		sum3 += x3 * x3
+	}
+
+	for ; i < len(ss); i++ {
+		x := float64(ss[i])
+		sum0 += x * x
+	}
+
+	return math.Sqrt((sum0 + sum1) + (sum2 + sum3))
+}
@@ -0,0 +1,28 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var normTests = []struct {
+	ss       []int8
+	expected float64
+}{
+	{nil, 0},
+	{[]int8{-7}, 7},
+	{[]int8{3, 4}, 5},
+	{[]int8{1, 1, 1, 1, 1, 1, 1, 1, 1}, 3},
+	// The squares would overflow an int8.
+	{[]int8{100, 100, 100, 100}, 200},
+}
+
+func TestNorm(t *testing.T) {
+	for _, test := range normTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.Norm(test.ss))
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package pie
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// NormalizeL1 divides each element by the sum of the absolute values of the
+// elements (the L1 norm), so that the absolute values add up to 1:
+//
+//	NormalizeL1([1, -3, 4]) => [0.125, -0.375, 0.5]
+//
+// If every element is zero, every value is 0. nil is returned if there are no
+// elements in the slice.
+func NormalizeL1[T constraints.Integer | constraints.Float](ss []T) []float64 {
+	var norm float64
+	for _, s := range ss {
+		norm += math.Abs(float64(s))
+	}
+
+	return divideBy(ss, norm)
+}
+
+// divideBy returns each element divided by norm, or zeros if norm is zero.
+func divideBy[T constraints.Integer | constraints.Float](ss []T, norm float64) []float64 {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	result := make([]float64, len(ss))
+	if norm == 0 {
+		return result
+	}
+
+	for i, s := range ss {
+		result[i] = float64(s) / norm
+	}
+
+	return result
+}
@@ -0,0 +1,26 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var normalizeL1Tests = []struct {
+	ss       []int
+	expected []float64
+}{
+	{nil, nil},
+	{[]int{0, 0}, []float64{0, 0}},
+	{[]int{5}, []float64{1}},
+	{[]int{1, -3, 4}, []float64{0.125, -0.375, 0.5}},
+}
+
+func TestNormalizeL1(t *testing.T) {
+	for _, test := range normalizeL1Tests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.NormalizeL1(test.ss))
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package pie
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// NormalizeL2 divides each element by the Euclidean length of the elements
+// (the L2 norm), so that the result is a unit vector:
+//
+//	NormalizeL2([3, 4]) => [0.6, 0.8]
+//
+// If every element is zero, every value is 0. nil is returned if there are no
+// elements in the slice.
+func NormalizeL2[T constraints.Integer | constraints.Float](ss []T) []float64 {
+	var norm float64
+	for _, s := range ss {
+		norm = math.Hypot(norm, float64(s))
+	}
+
+	return divideBy(ss, norm)
+}
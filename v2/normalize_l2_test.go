@@ -0,0 +1,36 @@
+package pie_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var normalizeL2Tests = []struct {
+	ss       []float64
+	expected []float64
+}{
+	{nil, nil},
+	{[]float64{0, 0}, []float64{0, 0}},
+	{[]float64{-5}, []float64{-1}},
+	{[]float64{3, 4}, []float64{0.6, 0.8}},
+	// Squaring the elements would overflow.
+	{[]float64{3e200, -4e200}, []float64{0.6, -0.8}},
+}
+
+func TestNormalizeL2(t *testing.T) {
+	for _, test := range normalizeL2Tests {
+		t.Run("", func(t *testing.T) {
+			assert.InDeltaSlice(t, test.expected, pie.NormalizeL2(test.ss), 1e-12)
+		})
+	}
+}
+
+func TestNormalizeL2IsUnitLength(t *testing.T) {
+	normalized := pie.NormalizeL2([]int{1, 2, 3, 4, 5})
+	assert.InDelta(t, 1, math.Sqrt(pie.Sum(pie.Map(normalized, func(x float64) float64 {
+		return x * x
+	}))), 1e-12)
+}
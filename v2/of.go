@@ -1,7 +1,10 @@
+// Code generated by go generate; DO NOT EDIT.
+
 package pie
 
 import (
 	"context"
+	"io"
 	"math/rand"
 )
 
@@ -43,6 +46,74 @@ func (o OfSlice[T]) Bottom(n int) OfSlice[T] {
 	return OfSlice[T]{Bottom(o.Result, n)}
 }
 
+// Chunk splits the input and returns multi slices whose length equals chunkLength,
+// except for the last slice which may contain fewer elements. The chunks are
+// copied from the input, so appending to one chunk will not change the next.
+//
+// Examples:
+//
+//	Chunk([1, 2, 3], 4) => [ [1, 2, 3] ]
+//	Chunk([1, 2, 3], 3) => [ [1, 2, 3] ]
+//	Chunk([1, 2, 3], 2) => [ [1, 2], [3] ]
+//	Chunk([1, 2, 3], 1) => [ [1], [2], [3] ]
+//	Chunk([], 1)        => [ [] ]
+//	Chunk([1, 2, 3], 0) => panic: chunkLength should be greater than 0
+func (o OfSlice[T]) Chunk(chunkLength int) [][]T {
+	return Chunk(o.Result, chunkLength)
+}
+
+// Combinations returns every way to choose k elements from ss, where the order
+// does not matter. See CombinationsSeq for the order.
+//
+// ErrTooManyResults is returned (without generating any combinations) if there
+// would be more than max.
+func (o OfSlice[T]) Combinations(k int, max int) ([][]T, error) {
+	return Combinations(o.Result, k, max)
+}
+
+// CombinationsSeq returns a sequence (that can be used as an iter.Seq) of
+// every way to choose k elements from ss, where the order does not matter.
+// Elements are treated as different based on their position, not their value.
+//
+// The chosen elements keep their order from ss, and the combinations are
+// produced in lexicographic order of the positions:
+//
+//	CombinationsSeq([a, b, c], 2) => [a, b], [a, c], [b, c]
+//
+// Nothing is produced if k is negative or greater than the length of ss. They
+// are generated lazily and each one is a new slice.
+func (o OfSlice[T]) CombinationsSeq(k int) func(yield func([]T) bool) {
+	return CombinationsSeq(o.Result, k)
+}
+
+// CombinationsWithReplacement returns every way to choose k elements from ss,
+// where the order does not matter and each element can be chosen more than
+// once. See CombinationsWithReplacementSeq for the order.
+//
+// ErrTooManyResults is returned (without generating any combinations) if there
+// would be more than max.
+func (o OfSlice[T]) CombinationsWithReplacement(k int, max int) ([][]T, error) {
+	return CombinationsWithReplacement(o.Result, k, max)
+}
+
+// CombinationsWithReplacementSeq returns a sequence (that can be used as an
+// iter.Seq) of every way to choose k elements from ss, where the order does
+// not matter and each element can be chosen more than once:
+//
+//	CombinationsWithReplacementSeq([a, b, c], 2) => [a, a], [a, b], [a, c], [b, b], [b, c], [c, c]
+//
+// Nothing is produced if k is negative, or if ss is empty and k is greater
+// than zero. They are generated lazily and each one is a new slice.
+func (o OfSlice[T]) CombinationsWithReplacementSeq(k int) func(yield func([]T) bool) {
+	return CombinationsWithReplacementSeq(o.Result, k)
+}
+
+// Removes elements at indices in idx from input slice, returns resulting slice.
+// If an index is out of bounds, skip it.
+func (o OfSlice[T]) Delete(idx ...int) OfSlice[T] {
+	return OfSlice[T]{Delete(o.Result, idx...)}
+}
+
 // DropTop will return the rest slice after dropping the top n elements
 // if the slice has less elements then n that'll return empty slice
 // if n < 0 it'll return empty slice.
@@ -51,7 +122,7 @@ func (o OfSlice[T]) DropTop(n int) OfSlice[T] {
 }
 
 // Each is more condensed version of Transform that allows an action to happen
-// on each elements and pass the original slice on.
+// on each elements and pass a copy of the slice on.
 //
 //	pie.Each(cars, func (car *Car) {
 //	    fmt.Printf("Car color is: %s\n", car.Color)
@@ -68,6 +139,14 @@ func (o OfSlice[T]) Each(fn func(T)) OfSlice[T] {
 	return OfSlice[T]{Each(o.Result, fn)}
 }
 
+// Enumerate returns a new slice of pairs, where A is the index and B is the
+// element of ss at that index:
+//
+//	Enumerate([a, b, c]) => [{0, a}, {1, b}, {2, c}]
+func (o OfSlice[T]) Enumerate() []Zipped[int, T] {
+	return Enumerate(o.Result)
+}
+
 // Filter will return a new slice containing only the elements that return
 // true from the condition. The returned slice may contain zero elements (nil).
 //
@@ -76,6 +155,21 @@ func (o OfSlice[T]) Filter(condition func(T) bool) OfSlice[T] {
 	return OfSlice[T]{Filter(o.Result, condition)}
 }
 
+// FilterMap maps and filters in a single pass. The function returns the mapped
+// value and whether it should be included in the result.
+//
+// The returned slice may contain zero elements (nil).
+//
+// For example, parsing only the valid numbers:
+//
+//	pie.FilterMap([]string{"1", "x", "3"}, func(s string) (int, bool) {
+//	    i, err := strconv.Atoi(s)
+//	    return i, err == nil
+//	}) // [1 3]
+func (o OfSlice[T]) FilterMap(fn func(T) (T, bool)) OfSlice[T] {
+	return OfSlice[T]{FilterMap(o.Result, fn)}
+}
+
 // FilterNot works the same as Filter, with a negated condition. That is, it will
 // return a new slice only containing the elements that returned false from the
 // condition. The returned slice may contain zero elements (nil).
@@ -103,11 +197,63 @@ func (o OfSlice[T]) FirstOr(defaultValue T) T {
 	return FirstOr(o.Result, defaultValue)
 }
 
-// Insert a value at an index.
+// FlatMap maps each element to a slice and concatenates the results into a
+// single slice. It is equivalent to Flat(Map(ss, fn)) without the intermediate
+// two-dimensional slice.
+//
+// The returned slice may contain zero elements (nil).
+//
+// Examples:
+//
+//	FlatMap(["a b", "c"], strings.Fields) => ["a", "b", "c"]
+func (o OfSlice[T]) FlatMap(fn func(T) []T) OfSlice[T] {
+	return OfSlice[T]{FlatMap(o.Result, fn)}
+}
+
+// Fold applies fn to each element from left to right, threading an
+// accumulator that starts as initial. Unlike Reduce, the accumulator may be a
+// different type to the elements.
+//
+// initial is returned if there are no elements in the slice.
+//
+// For example, summarising orders without converting them first:
+//
+//	total := pie.Fold(orders, 0.0, func(total float64, o Order) float64 {
+//	    return total + o.Amount
+//	})
+func (o OfSlice[T]) Fold(initial T, fn func(T, T) T) T {
+	return Fold(o.Result, initial, fn)
+}
+
+// FoldRight is the same as Fold except the elements are visited from right to
+// left, starting at the last element.
+//
+// initial is returned if there are no elements in the slice.
+func (o OfSlice[T]) FoldRight(initial T, fn func(T, T) T) T {
+	return FoldRight(o.Result, initial, fn)
+}
+
+// Insert a value at an index. If the index is greater than or equal to the
+// length of the slice, the values are appended to the end.
 func (o OfSlice[T]) Insert(index int, values ...T) OfSlice[T] {
 	return OfSlice[T]{Insert(o.Result, index, values...)}
 }
 
+// JSONMarshal returns the JSON encoded array. Unlike JSONBytes, it works with
+// any element type (such as structs and pointers) and returns the error from
+// the encoder, for example for a NaN float or a failing json.Marshaler.
+//
+// Like JSONBytes, a nil slice is encoded as an empty array rather than null.
+func (o OfSlice[T]) JSONMarshal() ([]byte, error) {
+	return JSONMarshal(o.Result)
+}
+
+// JSONMarshalIndent works like JSONMarshal, but applies prefix and indent in
+// the same way as json.MarshalIndent.
+func (o OfSlice[T]) JSONMarshalIndent(prefix, indent string) ([]byte, error) {
+	return JSONMarshalIndent(o.Result, prefix, indent)
+}
+
 // Last returns the last element or a zero value if there are no elements.
 func (o OfSlice[T]) Last() T {
 	return Last(o.Result)
@@ -124,10 +270,120 @@ func (o OfSlice[T]) LastOr(defaultValue T) T {
 // Be careful when using this with slices of pointers. If you modify the input
 // value it will affect the original slice. Be sure to return a new allocated
 // object or deep copy the existing one.
+//
+// The element type can be changed by the mapping. Since Go methods cannot
+// introduce type parameters, the Map method of OfSlice (and friends) must
+// return the same type. To continue a chain after changing the type, pass the
+// Result through Map and wrap it again:
+//
+//	ids := pie.OfOrdered(pie.Map(pie.Of(users).Filter(isActive).Result, User.ID)).
+//	    Unique().
+//	    Result
+//
+// See also MapIndexed, FlatMap and FilterMap.
 func (o OfSlice[T]) Map(fn func(T) T) OfSlice[T] {
 	return OfSlice[T]{Map(o.Result, fn)}
 }
 
+// MapIndexed is the same as Map except the function also receives the index of
+// each element.
+//
+// The number of elements returned will always be the same as the input.
+func (o OfSlice[T]) MapIndexed(fn func(int, T) T) OfSlice[T] {
+	return OfSlice[T]{MapIndexed(o.Result, fn)}
+}
+
+// Permutations returns every ordering of the elements in ss. See
+// PermutationsSeq for the order.
+//
+// There are n! permutations, so ErrTooManyResults is returned (without
+// generating any permutations) if there would be more than max.
+func (o OfSlice[T]) Permutations(max int) ([][]T, error) {
+	return Permutations(o.Result, max)
+}
+
+// PermutationsSeq returns a sequence (that can be used as an iter.Seq) of every
+// ordering of the elements in ss. Elements are treated as different based on
+// their position, not their value, so equal elements produce repeated
+// permutations.
+//
+// The permutations are produced in lexicographic order of the positions:
+//
+//	PermutationsSeq([a, b, c]) => [a, b, c], [a, c, b], [b, a, c], [b, c, a], ...
+//
+// There are n! permutations, including a single empty permutation if ss is
+// empty. They are generated lazily and each one is a new slice.
+func (o OfSlice[T]) PermutationsSeq() func(yield func([]T) bool) {
+	return PermutationsSeq(o.Result)
+}
+
+// Pop the first element of the slice
+//
+// Usage Example:
+//
+//	type knownGreetings []string
+//	greetings := knownGreetings{"ciao", "hello", "hola"}
+//	for greeting := greetings.Pop(); greeting != nil; greeting = greetings.Pop() {
+//	    fmt.Println(*greeting)
+//	}
+func (o *OfSlice[T]) Pop() *T {
+	return Pop(&o.Result)
+}
+
+// PowerSet returns every subset of ss. See PowerSetSeq for the order.
+//
+// There are 2^n subsets, so ErrTooManyResults is returned (without generating
+// any subsets) if there would be more than max.
+func (o OfSlice[T]) PowerSet(max int) ([][]T, error) {
+	return PowerSet(o.Result, max)
+}
+
+// PowerSetSeq returns a sequence (that can be used as an iter.Seq) of every
+// subset of ss, starting with the empty subset. The subsets are produced in
+// order of their size, and then in the same order as CombinationsSeq:
+//
+//	PowerSetSeq([a, b, c]) => [], [a], [b], [c], [a, b], [a, c], [b, c], [a, b, c]
+//
+// There are 2^n subsets. They are generated lazily and each one is a new slice.
+func (o OfSlice[T]) PowerSetSeq() func(yield func([]T) bool) {
+	return PowerSetSeq(o.Result)
+}
+
+// Random returns a random element by your rand.Source. If the slice is empty,
+// the zero value and false are returned.
+func (o OfSlice[T]) Random(source rand.Source) (T, bool) {
+	return Random(o.Result, source)
+}
+
+// Reduce continually applies the provided function
+// over the slice. Reducing the elements to a single value.
+//
+// Returns a zero value of T if there are no elements in the slice. It will
+// panic if the reducer is nil and the slice has more than one element (required
+// to invoke reduce). Otherwise returns result of applying reducer from left to
+// right.
+//
+// Use ReduceOk to distinguish an empty slice from a zero result, or Fold to
+// reduce into a different type with an explicit initial value.
+func (o OfSlice[T]) Reduce(reducer func(T, T) T) T {
+	return Reduce(o.Result, reducer)
+}
+
+// ReduceOk is the same as Reduce except that it also returns false if there
+// are no elements in the slice. This allows a legitimate zero value result to
+// be distinguished from an empty input.
+func (o OfSlice[T]) ReduceOk(reducer func(T, T) T) (T, bool) {
+	return ReduceOk(o.Result, reducer)
+}
+
+// ReduceRight is the same as Reduce except the elements are reduced from right
+// to left. The last element is used as the initial value.
+//
+// Returns a zero value of T if there are no elements in the slice.
+func (o OfSlice[T]) ReduceRight(reducer func(T, T) T) T {
+	return ReduceRight(o.Result, reducer)
+}
+
 // Reverse returns a new copy of the slice with the elements ordered in reverse.
 // This is useful when combined with Sort to get a descending sort order:
 //
@@ -136,13 +392,52 @@ func (o OfSlice[T]) Reverse() OfSlice[T] {
 	return OfSlice[T]{Reverse(o.Result)}
 }
 
-// Rotate returns slice circularly rotated by a number of positions n.
+// Rotate return slice circularly rotated by a number of positions n.
 // If n is positive, the slice is rotated right.
 // If n is negative, the slice is rotated left.
 func (o OfSlice[T]) Rotate(n int) OfSlice[T] {
 	return OfSlice[T]{Rotate(o.Result, n)}
 }
 
+// Sample returns k different elements chosen at random by your rand.Source.
+// Each element can only be chosen once, although equal values may appear more
+// than once if they are in the input more than once.
+//
+// The chosen elements are returned in the same order that they appear in ss.
+// If k is greater than or equal to the length of ss a copy of ss is returned,
+// and if k is less than 1 nil is returned.
+//
+// Sample uses Floyd's algorithm, so it only needs k random numbers regardless
+// of the length of ss.
+func (o OfSlice[T]) Sample(k int, source rand.Source) OfSlice[T] {
+	return OfSlice[T]{Sample(o.Result, k, source)}
+}
+
+// SampleWithReplacement returns k elements chosen at random by your
+// rand.Source. Unlike Sample, the same element may be chosen more than once and
+// k can be greater than the length of ss.
+//
+// If ss is empty or k is less than 1, nil is returned.
+func (o OfSlice[T]) SampleWithReplacement(k int, source rand.Source) OfSlice[T] {
+	return OfSlice[T]{SampleWithReplacement(o.Result, k, source)}
+}
+
+// Scan is like Reduce, but returns every intermediate accumulator rather than
+// only the final one. The accumulator starts at initial and may be a different
+// type to the elements.
+//
+// The result has the same length as ss, where each value is the accumulator
+// after applying fn to the element at the same position. The initial value is
+// not included. nil is returned if there are no elements in the slice.
+//
+// Examples:
+//
+//	Scan([1, 2, 3], 10, +)                  => [11, 13, 16]
+//	Scan(["a", "b", "c"], "", concatenate)  => ["a", "ab", "abc"]
+func (o OfSlice[T]) Scan(initial T, fn func(T, T) T) OfSlice[T] {
+	return OfSlice[T]{Scan(o.Result, initial, fn)}
+}
+
 // Send sends elements to channel
 // in normal act it sends all elements but if func canceled it can be less
 //
@@ -171,12 +466,47 @@ func (o OfSlice[T]) SequenceUsing(creator func(int) T, params ...int) OfSlice[T]
 	return OfSlice[T]{SequenceUsing(o.Result, creator, params...)}
 }
 
+// Shift will return two values: the shifted value and the rest slice.
+// if the slice is empty then returned shifted value is the zero value of the slice elements and the rest slice is empty slice
+func (o OfSlice[T]) Shift() (T, []T) {
+	return Shift(o.Result)
+}
+
 // Shuffle returns a new shuffled slice by your rand.Source. The original slice
 // is not modified.
 func (o OfSlice[T]) Shuffle(source rand.Source) OfSlice[T] {
 	return OfSlice[T]{Shuffle(o.Result, source)}
 }
 
+// ShuffleSecure returns a new shuffled slice using crypto/rand. It is suitable
+// for shuffles that must not be predictable, such as lottery draws. Each order
+// is equally likely. The original slice is not modified.
+//
+// An error is only returned if crypto/rand fails.
+func (o OfSlice[T]) ShuffleSecure() ([]T, error) {
+	return ShuffleSecure(o.Result)
+}
+
+// ShuffleSeed returns a new shuffled slice that is always the same for the
+// same seed. The original slice is not modified.
+//
+// Unlike Shuffle, the order does not depend on the math/rand implementation.
+// It uses SplitMix64 for the random numbers, Lemire's method to choose each
+// index and a Fisher-Yates shuffle (swapping from the last element down). The
+// order for a seed and slice length will not change between versions of pie
+// or Go, so it is safe to use in tests or to store the seed to reproduce a
+// shuffle later.
+func (o OfSlice[T]) ShuffleSeed(seed uint64) OfSlice[T] {
+	return OfSlice[T]{ShuffleSeed(o.Result, seed)}
+}
+
+// ShuffleWith returns a new shuffled slice by your Uint64Source. The original
+// slice is not modified. Unlike Shuffle, it does not need to create a
+// rand.Rand for each call.
+func (o OfSlice[T]) ShuffleWith(source Uint64Source) OfSlice[T] {
+	return OfSlice[T]{ShuffleWith(o.Result, source)}
+}
+
 // SortUsing works similar to sort.Slice. However, unlike sort.Slice the
 // slice returned will be reallocated as to not modify the input slice.
 func (o OfSlice[T]) SortUsing(less func(a, b T) bool) OfSlice[T] {
@@ -198,6 +528,44 @@ func (o OfSlice[T]) SubSlice(start int, end int) OfSlice[T] {
 	return OfSlice[T]{SubSlice(o.Result, start, end)}
 }
 
+// Table writes ss to w as a table with a header row, aligning the columns so
+// that they are easy to read in a terminal:
+//
+//	+----+------+
+//	| ID | Name |
+//	+----+------+
+//	|  1 | Bob  |
+//	| 23 | Jane |
+//	+----+------+
+//
+// If no columns are provided and T is a struct (or a pointer to a struct),
+// there will be a column for each exported field. Otherwise, there will be a
+// single column called "Value".
+//
+// Widths are counted in runes, so characters that are displayed wider (or
+// narrower) than others may not line up. New lines in cells are replaced with
+// spaces.
+func (o OfSlice[T]) Table(w io.Writer, style TableStyle, columns ...TableColumn[T]) error {
+	return Table(w, o.Result, style, columns...)
+}
+
+// ToCSV writes ss to w as CSV with a header row, followed by one row for each
+// element. The header is always written, even if ss is empty.
+//
+// If no columns are provided, the columns from CSVFields are used, so T must
+// be a struct or a pointer to a struct. Otherwise, the columns are written in
+// the order they are provided:
+//
+//	pie.ToCSV(w, users,
+//		pie.CSVColumn[User]{Name: "id", Value: func(u User) string { return u.ID }},
+//		pie.CSVColumn[User]{Name: "email", Value: func(u User) string { return u.Email }},
+//	)
+//
+// A *CSVError is returned if a field from CSVFields cannot be formatted.
+func (o OfSlice[T]) ToCSV(w io.Writer, columns ...CSVColumn[T]) error {
+	return ToCSV(w, o.Result, columns...)
+}
+
 // Top will return n elements from head of the slice
 // if the slice has less elements then n that'll return all elements
 // if n < 0 it'll return empty slice.
@@ -211,8 +579,84 @@ func (o OfSlice[T]) Unshift(elements ...T) OfSlice[T] {
 	return OfSlice[T]{Unshift(o.Result, elements...)}
 }
 
-// Removes element at index in idx from input slice, returns resulting slice.
-// If an index in idx out of bounds, skip it.
-func (o OfSlice[T]) Delete(idx ...int) OfSlice[T] {
-	return OfSlice[T]{Delete(o.Result, idx...)}
+// WeightedChoice returns a random element by your rand.Source, where the chance
+// of each element being chosen is proportional to its weight. Elements with a
+// weight of zero or less are never chosen.
+//
+// If there are no elements with a positive weight, the zero value and false
+// are returned.
+func (o OfSlice[T]) WeightedChoice(weight func(T) float64, source rand.Source) (T, bool) {
+	return WeightedChoice(o.Result, weight, source)
+}
+
+// WeightedSample returns k different elements chosen at random by your
+// rand.Source, where the chance of each element being chosen is proportional
+// to its weight. Elements with a weight of zero or less are never chosen, so
+// fewer than k elements are returned if there are not enough elements with a
+// positive weight.
+//
+// Like Sample, each element can only be chosen once and the chosen elements are
+// returned in the same order that they appear in ss. If k is less than 1, nil
+// is returned.
+//
+// WeightedSample uses the algorithm by Efraimidis and Spirakis, which gives
+// each element a random key based on its weight and chooses the k largest
+// keys.
+func (o OfSlice[T]) WeightedSample(k int, weight func(T) float64, source rand.Source) OfSlice[T] {
+	return OfSlice[T]{WeightedSample(o.Result, k, weight, source)}
+}
+
+// WriteJSONLines writes each element of ss to w as a single line of JSON (also
+// known as JSON Lines or NDJSON). Elements are encoded and written one at a
+// time, so the whole output is never held in memory.
+//
+// Nothing is written for an empty slice. If an element cannot be encoded, the
+// elements before it will have already been written and the error includes the
+// index of the element.
+func (o OfSlice[T]) WriteJSONLines(w io.Writer) error {
+	return WriteJSONLines(w, o.Result)
+}
+
+// Zip will return a new slice containing pairs with elements from input slices.
+// If input slices have diffrent length, the output slice will be truncated to
+// the length of the smallest input slice.
+func (o OfSlice[T]) Zip(ss2 []T) []Zipped[T, T] {
+	return Zip(o.Result, ss2)
+}
+
+// Zip3 works like Zip for three slices. The output slice will be truncated to
+// the length of the smallest input slice.
+func (o OfSlice[T]) Zip3(ss2 []T, ss3 []T) []Zipped3[T, T, T] {
+	return Zip3(o.Result, ss2, ss3)
+}
+
+// ZipLongest will return a new slice containing pairs with elements from input slices.
+// If input slices have diffrent length, missing elements will be padded with default values.
+func (o OfSlice[T]) ZipLongest(ss2 []T) []Zipped[T, T] {
+	return ZipLongest(o.Result, ss2)
+}
+
+// ZipLongestWith works like ZipLongest, except that missing elements are
+// padded with fill1 (when ss1 is shorter) or fill2 (when ss2 is shorter)
+// instead of the zero value.
+func (o OfSlice[T]) ZipLongestWith(ss2 []T, fill1 T, fill2 T) []Zipped[T, T] {
+	return ZipLongestWith(o.Result, ss2, fill1, fill2)
+}
+
+// ZipStrict works like Zip, except that it returns ErrUnequalLengths (and a nil
+// slice) if ss1 and ss2 are not the same length, rather than truncating.
+func (o OfSlice[T]) ZipStrict(ss2 []T) ([]Zipped[T, T], error) {
+	return ZipStrict(o.Result, ss2)
+}
+
+// ZipWith returns a new slice containing the result of fn for each pair of
+// elements from ss1 and ss2. It is the same as mapping the result of Zip, but
+// without creating the pairs:
+//
+//	ZipWith([1, 2, 3], [10, 20], add) => [11, 22]
+//
+// If the input slices have different lengths, the output slice will be
+// truncated to the length of the smallest input slice.
+func (o OfSlice[T]) ZipWith(ss2 []T, fn func(T, T) T) OfSlice[T] {
+	return OfSlice[T]{ZipWith(o.Result, ss2, fn)}
 }
@@ -0,0 +1,798 @@
+// Code generated by go generate; DO NOT EDIT.
+
+package pie
+
+import (
+	"context"
+	"io"
+	"math/rand"
+)
+
+// OfComparable encapsulates a slice to be used in multiple chained operations.
+// OfComparable requires that elements be comparable, such as structs without
+// slice, map or function fields, for certain operations to be performed.
+func OfComparable[T comparable](ss []T) OfComparableSlice[T] {
+	return OfComparableSlice[T]{ss}
+}
+
+// OfComparableSlice provides the proxy methods that operate on slices. If the
+// last method in the chain does not return a single value, you can access the
+// Result to get final slice.
+type OfComparableSlice[T comparable] struct {
+	Result []T
+}
+
+// All will return true if all callbacks return true. It follows the same logic
+// as the all() function in Python.
+//
+// If the list is empty then true is always returned.
+func (o OfComparableSlice[T]) All(fn func(value T) bool) bool {
+	return All(o.Result, fn)
+}
+
+// Any will return true if any callbacks return true. It follows the same logic
+// as the any() function in Python.
+//
+// If the list is empty then false is always returned.
+func (o OfComparableSlice[T]) Any(fn func(value T) bool) bool {
+	return Any(o.Result, fn)
+}
+
+// AreUnique will return true if the slice contains elements that are all
+// different (unique) from each other.
+func (o OfComparableSlice[T]) AreUnique() bool {
+	return AreUnique(o.Result)
+}
+
+// Bottom will return n elements from bottom
+//
+// that means that elements is taken from the end of the slice
+// for this [1,2,3] slice with n == 2 will be returned [3,2]
+// if the slice has less elements then n that'll return all elements
+// if n < 0 it'll return empty slice.
+func (o OfComparableSlice[T]) Bottom(n int) OfComparableSlice[T] {
+	return OfComparableSlice[T]{Bottom(o.Result, n)}
+}
+
+// Chunk splits the input and returns multi slices whose length equals chunkLength,
+// except for the last slice which may contain fewer elements. The chunks are
+// copied from the input, so appending to one chunk will not change the next.
+//
+// Examples:
+//
+//	Chunk([1, 2, 3], 4) => [ [1, 2, 3] ]
+//	Chunk([1, 2, 3], 3) => [ [1, 2, 3] ]
+//	Chunk([1, 2, 3], 2) => [ [1, 2], [3] ]
+//	Chunk([1, 2, 3], 1) => [ [1], [2], [3] ]
+//	Chunk([], 1)        => [ [] ]
+//	Chunk([1, 2, 3], 0) => panic: chunkLength should be greater than 0
+func (o OfComparableSlice[T]) Chunk(chunkLength int) [][]T {
+	return Chunk(o.Result, chunkLength)
+}
+
+// Combinations returns every way to choose k elements from ss, where the order
+// does not matter. See CombinationsSeq for the order.
+//
+// ErrTooManyResults is returned (without generating any combinations) if there
+// would be more than max.
+func (o OfComparableSlice[T]) Combinations(k int, max int) ([][]T, error) {
+	return Combinations(o.Result, k, max)
+}
+
+// CombinationsSeq returns a sequence (that can be used as an iter.Seq) of
+// every way to choose k elements from ss, where the order does not matter.
+// Elements are treated as different based on their position, not their value.
+//
+// The chosen elements keep their order from ss, and the combinations are
+// produced in lexicographic order of the positions:
+//
+//	CombinationsSeq([a, b, c], 2) => [a, b], [a, c], [b, c]
+//
+// Nothing is produced if k is negative or greater than the length of ss. They
+// are generated lazily and each one is a new slice.
+func (o OfComparableSlice[T]) CombinationsSeq(k int) func(yield func([]T) bool) {
+	return CombinationsSeq(o.Result, k)
+}
+
+// CombinationsWithReplacement returns every way to choose k elements from ss,
+// where the order does not matter and each element can be chosen more than
+// once. See CombinationsWithReplacementSeq for the order.
+//
+// ErrTooManyResults is returned (without generating any combinations) if there
+// would be more than max.
+func (o OfComparableSlice[T]) CombinationsWithReplacement(k int, max int) ([][]T, error) {
+	return CombinationsWithReplacement(o.Result, k, max)
+}
+
+// CombinationsWithReplacementSeq returns a sequence (that can be used as an
+// iter.Seq) of every way to choose k elements from ss, where the order does
+// not matter and each element can be chosen more than once:
+//
+//	CombinationsWithReplacementSeq([a, b, c], 2) => [a, a], [a, b], [a, c], [b, b], [b, c], [c, c]
+//
+// Nothing is produced if k is negative, or if ss is empty and k is greater
+// than zero. They are generated lazily and each one is a new slice.
+func (o OfComparableSlice[T]) CombinationsWithReplacementSeq(k int) func(yield func([]T) bool) {
+	return CombinationsWithReplacementSeq(o.Result, k)
+}
+
+// Compact returns a new slice with all of the zero values (such as 0, "" or
+// nil) removed. The order of the remaining elements is retained.
+//
+// The returned slice may contain zero elements (nil).
+//
+// Compact is not the same as slices.Compact from the standard library, which
+// removes consecutive duplicates.
+func (o OfComparableSlice[T]) Compact() OfComparableSlice[T] {
+	return OfComparableSlice[T]{Compact(o.Result)}
+}
+
+// Contains returns true if the element exists in the slice.
+//
+// When using slices of pointers it will only compare by address, not value.
+func (o OfComparableSlice[T]) Contains(lookingFor T) bool {
+	return Contains(o.Result, lookingFor)
+}
+
+// Dedup returns a new slice where each run of consecutive equal elements is
+// replaced with the first element of the run. Unlike Unique, elements that are
+// equal but not next to each other are kept, and the order is unchanged:
+//
+//	Dedup([a, a, b, b, b, a, c]) => [a, b, a, c]
+//
+// Sort the slice first to remove all duplicates. See inplace.Dedup to modify
+// the slice instead of allocating a new one.
+func (o OfComparableSlice[T]) Dedup() OfComparableSlice[T] {
+	return OfComparableSlice[T]{Dedup(o.Result)}
+}
+
+// Removes elements at indices in idx from input slice, returns resulting slice.
+// If an index is out of bounds, skip it.
+func (o OfComparableSlice[T]) Delete(idx ...int) OfComparableSlice[T] {
+	return OfComparableSlice[T]{Delete(o.Result, idx...)}
+}
+
+// Diff returns the elements that needs to be added or removed from the first
+// slice to have the same elements in the second slice.
+//
+// The order of elements is not taken into consideration, so the slices are
+// treated sets that allow duplicate items.
+//
+// The added and removed returned may be blank respectively, or contain upto as
+// many elements that exists in the largest slice.
+func (o OfComparableSlice[T]) Diff(against []T) ([]T, []T) {
+	return Diff(o.Result, against)
+}
+
+// DropTop will return the rest slice after dropping the top n elements
+// if the slice has less elements then n that'll return empty slice
+// if n < 0 it'll return empty slice.
+func (o OfComparableSlice[T]) DropTop(n int) OfComparableSlice[T] {
+	return OfComparableSlice[T]{DropTop(o.Result, n)}
+}
+
+// Drop items from the slice while f(item) is true.
+// Afterwards, return every element until the slice is empty. It follows the
+// same logic as the dropwhile() function from itertools in Python.
+func (o OfComparableSlice[T]) DropWhile(f func(s T) bool) OfComparableSlice[T] {
+	return OfComparableSlice[T]{DropWhile(o.Result, f)}
+}
+
+// Each is more condensed version of Transform that allows an action to happen
+// on each elements and pass a copy of the slice on.
+//
+//	pie.Each(cars, func (car *Car) {
+//	    fmt.Printf("Car color is: %s\n", car.Color)
+//	})
+//
+// Pie will not ensure immutability on items passed in so they can be
+// manipulated, if you choose to do it this way, for example:
+//
+//	// Set all car colors to Red.
+//	pie.Each(cars, func (car *Car) {
+//	    car.Color = "Red"
+//	})
+func (o OfComparableSlice[T]) Each(fn func(T)) OfComparableSlice[T] {
+	return OfComparableSlice[T]{Each(o.Result, fn)}
+}
+
+// Enumerate returns a new slice of pairs, where A is the index and B is the
+// element of ss at that index:
+//
+//	Enumerate([a, b, c]) => [{0, a}, {1, b}, {2, c}]
+func (o OfComparableSlice[T]) Enumerate() []Zipped[int, T] {
+	return Enumerate(o.Result)
+}
+
+// Equals compare elements from the start to the end,
+//
+// if they are the same is considered the slices are equal if all elements are
+// the same is considered the slices are equal
+// if each slice == nil is considered that they're equal
+//
+// if element realizes Equals interface it uses that method, in other way uses
+// default compare
+func (o OfComparableSlice[T]) Equals(rhs []T) bool {
+	return Equals(o.Result, rhs)
+}
+
+// Filter will return a new slice containing only the elements that return
+// true from the condition. The returned slice may contain zero elements (nil).
+//
+// FilterNot works in the opposite way of Filter.
+func (o OfComparableSlice[T]) Filter(condition func(T) bool) OfComparableSlice[T] {
+	return OfComparableSlice[T]{Filter(o.Result, condition)}
+}
+
+// FilterMap maps and filters in a single pass. The function returns the mapped
+// value and whether it should be included in the result.
+//
+// The returned slice may contain zero elements (nil).
+//
+// For example, parsing only the valid numbers:
+//
+//	pie.FilterMap([]string{"1", "x", "3"}, func(s string) (int, bool) {
+//	    i, err := strconv.Atoi(s)
+//	    return i, err == nil
+//	}) // [1 3]
+func (o OfComparableSlice[T]) FilterMap(fn func(T) (T, bool)) OfComparableSlice[T] {
+	return OfComparableSlice[T]{FilterMap(o.Result, fn)}
+}
+
+// FilterNot works the same as Filter, with a negated condition. That is, it will
+// return a new slice only containing the elements that returned false from the
+// condition. The returned slice may contain zero elements (nil).
+func (o OfComparableSlice[T]) FilterNot(condition func(T) bool) OfComparableSlice[T] {
+	return OfComparableSlice[T]{FilterNot(o.Result, condition)}
+}
+
+// FindFirstUsing will return the index of the first element when the callback
+// returns true or -1 if no element is found.
+// It follows the same logic as the findIndex() function in Javascript.
+//
+// If the list is empty then -1 is always returned.
+func (o OfComparableSlice[T]) FindFirstUsing(fn func(value T) bool) int {
+	return FindFirstUsing(o.Result, fn)
+}
+
+// First returns the first element or a zero value if there are no elements.
+func (o OfComparableSlice[T]) First() T {
+	return First(o.Result)
+}
+
+// FirstOr returns the first element or a default value if there are no
+// elements.
+func (o OfComparableSlice[T]) FirstOr(defaultValue T) T {
+	return FirstOr(o.Result, defaultValue)
+}
+
+// FlatMap maps each element to a slice and concatenates the results into a
+// single slice. It is equivalent to Flat(Map(ss, fn)) without the intermediate
+// two-dimensional slice.
+//
+// The returned slice may contain zero elements (nil).
+//
+// Examples:
+//
+//	FlatMap(["a b", "c"], strings.Fields) => ["a", "b", "c"]
+func (o OfComparableSlice[T]) FlatMap(fn func(T) []T) OfComparableSlice[T] {
+	return OfComparableSlice[T]{FlatMap(o.Result, fn)}
+}
+
+// Fold applies fn to each element from left to right, threading an
+// accumulator that starts as initial. Unlike Reduce, the accumulator may be a
+// different type to the elements.
+//
+// initial is returned if there are no elements in the slice.
+//
+// For example, summarising orders without converting them first:
+//
+//	total := pie.Fold(orders, 0.0, func(total float64, o Order) float64 {
+//	    return total + o.Amount
+//	})
+func (o OfComparableSlice[T]) Fold(initial T, fn func(T, T) T) T {
+	return Fold(o.Result, initial, fn)
+}
+
+// FoldRight is the same as Fold except the elements are visited from right to
+// left, starting at the last element.
+//
+// initial is returned if there are no elements in the slice.
+func (o OfComparableSlice[T]) FoldRight(initial T, fn func(T, T) T) T {
+	return FoldRight(o.Result, initial, fn)
+}
+
+// Group returns a map of the value with an individual count.
+func (o OfComparableSlice[T]) Group() map[T]int {
+	return Group(o.Result)
+}
+
+// Insert a value at an index. If the index is greater than or equal to the
+// length of the slice, the values are appended to the end.
+func (o OfComparableSlice[T]) Insert(index int, values ...T) OfComparableSlice[T] {
+	return OfComparableSlice[T]{Insert(o.Result, index, values...)}
+}
+
+// Intersect returns items that exist in all lists.
+//
+// It returns slice without any duplicates.
+// If zero slice arguments are provided, then nil is returned.
+func (o OfComparableSlice[T]) Intersect(slices ...[]T) OfComparableSlice[T] {
+	return OfComparableSlice[T]{Intersect(o.Result, slices...)}
+}
+
+// JSONMarshal returns the JSON encoded array. Unlike JSONBytes, it works with
+// any element type (such as structs and pointers) and returns the error from
+// the encoder, for example for a NaN float or a failing json.Marshaler.
+//
+// Like JSONBytes, a nil slice is encoded as an empty array rather than null.
+func (o OfComparableSlice[T]) JSONMarshal() ([]byte, error) {
+	return JSONMarshal(o.Result)
+}
+
+// JSONMarshalIndent works like JSONMarshal, but applies prefix and indent in
+// the same way as json.MarshalIndent.
+func (o OfComparableSlice[T]) JSONMarshalIndent(prefix, indent string) ([]byte, error) {
+	return JSONMarshalIndent(o.Result, prefix, indent)
+}
+
+// Last returns the last element or a zero value if there are no elements.
+func (o OfComparableSlice[T]) Last() T {
+	return Last(o.Result)
+}
+
+// LastOr returns the last element or a default value if there are no elements.
+func (o OfComparableSlice[T]) LastOr(defaultValue T) T {
+	return LastOr(o.Result, defaultValue)
+}
+
+// LongestRun returns the longest run of consecutive equal elements. If there is
+// more than one run with the longest length, the first one is returned:
+//
+//	LongestRun([a, b, b, c, c]) => {b, 2}
+//
+// The Count is zero if there are no elements.
+func (o OfComparableSlice[T]) LongestRun() Run[T] {
+	return LongestRun(o.Result)
+}
+
+// Map will return a new slice where each element has been mapped (transformed).
+// The number of elements returned will always be the same as the input.
+//
+// Be careful when using this with slices of pointers. If you modify the input
+// value it will affect the original slice. Be sure to return a new allocated
+// object or deep copy the existing one.
+//
+// The element type can be changed by the mapping. Since Go methods cannot
+// introduce type parameters, the Map method of OfSlice (and friends) must
+// return the same type. To continue a chain after changing the type, pass the
+// Result through Map and wrap it again:
+//
+//	ids := pie.OfOrdered(pie.Map(pie.Of(users).Filter(isActive).Result, User.ID)).
+//	    Unique().
+//	    Result
+//
+// See also MapIndexed, FlatMap and FilterMap.
+func (o OfComparableSlice[T]) Map(fn func(T) T) OfComparableSlice[T] {
+	return OfComparableSlice[T]{Map(o.Result, fn)}
+}
+
+// MapIndexed is the same as Map except the function also receives the index of
+// each element.
+//
+// The number of elements returned will always be the same as the input.
+func (o OfComparableSlice[T]) MapIndexed(fn func(int, T) T) OfComparableSlice[T] {
+	return OfComparableSlice[T]{MapIndexed(o.Result, fn)}
+}
+
+// Mode returns a new slice containing the most frequently occuring values.
+//
+// The number of items returned may be the same as the input or less. It will
+// never return zero items unless the input slice has zero items.
+func (o OfComparableSlice[T]) Mode() OfComparableSlice[T] {
+	return OfComparableSlice[T]{Mode(o.Result)}
+}
+
+// Permutations returns every ordering of the elements in ss. See
+// PermutationsSeq for the order.
+//
+// There are n! permutations, so ErrTooManyResults is returned (without
+// generating any permutations) if there would be more than max.
+func (o OfComparableSlice[T]) Permutations(max int) ([][]T, error) {
+	return Permutations(o.Result, max)
+}
+
+// PermutationsSeq returns a sequence (that can be used as an iter.Seq) of every
+// ordering of the elements in ss. Elements are treated as different based on
+// their position, not their value, so equal elements produce repeated
+// permutations.
+//
+// The permutations are produced in lexicographic order of the positions:
+//
+//	PermutationsSeq([a, b, c]) => [a, b, c], [a, c, b], [b, a, c], [b, c, a], ...
+//
+// There are n! permutations, including a single empty permutation if ss is
+// empty. They are generated lazily and each one is a new slice.
+func (o OfComparableSlice[T]) PermutationsSeq() func(yield func([]T) bool) {
+	return PermutationsSeq(o.Result)
+}
+
+// Pop the first element of the slice
+//
+// Usage Example:
+//
+//	type knownGreetings []string
+//	greetings := knownGreetings{"ciao", "hello", "hola"}
+//	for greeting := greetings.Pop(); greeting != nil; greeting = greetings.Pop() {
+//	    fmt.Println(*greeting)
+//	}
+func (o *OfComparableSlice[T]) Pop() *T {
+	return Pop(&o.Result)
+}
+
+// PowerSet returns every subset of ss. See PowerSetSeq for the order.
+//
+// There are 2^n subsets, so ErrTooManyResults is returned (without generating
+// any subsets) if there would be more than max.
+func (o OfComparableSlice[T]) PowerSet(max int) ([][]T, error) {
+	return PowerSet(o.Result, max)
+}
+
+// PowerSetSeq returns a sequence (that can be used as an iter.Seq) of every
+// subset of ss, starting with the empty subset. The subsets are produced in
+// order of their size, and then in the same order as CombinationsSeq:
+//
+//	PowerSetSeq([a, b, c]) => [], [a], [b], [c], [a, b], [a, c], [b, c], [a, b, c]
+//
+// There are 2^n subsets. They are generated lazily and each one is a new slice.
+func (o OfComparableSlice[T]) PowerSetSeq() func(yield func([]T) bool) {
+	return PowerSetSeq(o.Result)
+}
+
+// Random returns a random element by your rand.Source. If the slice is empty,
+// the zero value and false are returned.
+func (o OfComparableSlice[T]) Random(source rand.Source) (T, bool) {
+	return Random(o.Result, source)
+}
+
+// Reduce continually applies the provided function
+// over the slice. Reducing the elements to a single value.
+//
+// Returns a zero value of T if there are no elements in the slice. It will
+// panic if the reducer is nil and the slice has more than one element (required
+// to invoke reduce). Otherwise returns result of applying reducer from left to
+// right.
+//
+// Use ReduceOk to distinguish an empty slice from a zero result, or Fold to
+// reduce into a different type with an explicit initial value.
+func (o OfComparableSlice[T]) Reduce(reducer func(T, T) T) T {
+	return Reduce(o.Result, reducer)
+}
+
+// ReduceOk is the same as Reduce except that it also returns false if there
+// are no elements in the slice. This allows a legitimate zero value result to
+// be distinguished from an empty input.
+func (o OfComparableSlice[T]) ReduceOk(reducer func(T, T) T) (T, bool) {
+	return ReduceOk(o.Result, reducer)
+}
+
+// ReduceRight is the same as Reduce except the elements are reduced from right
+// to left. The last element is used as the initial value.
+//
+// Returns a zero value of T if there are no elements in the slice.
+func (o OfComparableSlice[T]) ReduceRight(reducer func(T, T) T) T {
+	return ReduceRight(o.Result, reducer)
+}
+
+// Reverse returns a new copy of the slice with the elements ordered in reverse.
+// This is useful when combined with Sort to get a descending sort order:
+//
+//	ss.Sort().Reverse()
+func (o OfComparableSlice[T]) Reverse() OfComparableSlice[T] {
+	return OfComparableSlice[T]{Reverse(o.Result)}
+}
+
+// Rotate return slice circularly rotated by a number of positions n.
+// If n is positive, the slice is rotated right.
+// If n is negative, the slice is rotated left.
+func (o OfComparableSlice[T]) Rotate(n int) OfComparableSlice[T] {
+	return OfComparableSlice[T]{Rotate(o.Result, n)}
+}
+
+// RunLengthEncode returns a Run for each run of consecutive equal elements:
+//
+//	RunLengthEncode([a, a, b, a, a, a]) => [{a, 2}, {b, 1}, {a, 3}]
+//
+// Use RunLengthDecode to get the original elements back. nil is returned if
+// there are no elements.
+func (o OfComparableSlice[T]) RunLengthEncode() []Run[T] {
+	return RunLengthEncode(o.Result)
+}
+
+// Sample returns k different elements chosen at random by your rand.Source.
+// Each element can only be chosen once, although equal values may appear more
+// than once if they are in the input more than once.
+//
+// The chosen elements are returned in the same order that they appear in ss.
+// If k is greater than or equal to the length of ss a copy of ss is returned,
+// and if k is less than 1 nil is returned.
+//
+// Sample uses Floyd's algorithm, so it only needs k random numbers regardless
+// of the length of ss.
+func (o OfComparableSlice[T]) Sample(k int, source rand.Source) OfComparableSlice[T] {
+	return OfComparableSlice[T]{Sample(o.Result, k, source)}
+}
+
+// SampleWithReplacement returns k elements chosen at random by your
+// rand.Source. Unlike Sample, the same element may be chosen more than once and
+// k can be greater than the length of ss.
+//
+// If ss is empty or k is less than 1, nil is returned.
+func (o OfComparableSlice[T]) SampleWithReplacement(k int, source rand.Source) OfComparableSlice[T] {
+	return OfComparableSlice[T]{SampleWithReplacement(o.Result, k, source)}
+}
+
+// Scan is like Reduce, but returns every intermediate accumulator rather than
+// only the final one. The accumulator starts at initial and may be a different
+// type to the elements.
+//
+// The result has the same length as ss, where each value is the accumulator
+// after applying fn to the element at the same position. The initial value is
+// not included. nil is returned if there are no elements in the slice.
+//
+// Examples:
+//
+//	Scan([1, 2, 3], 10, +)                  => [11, 13, 16]
+//	Scan(["a", "b", "c"], "", concatenate)  => ["a", "ab", "abc"]
+func (o OfComparableSlice[T]) Scan(initial T, fn func(T, T) T) OfComparableSlice[T] {
+	return OfComparableSlice[T]{Scan(o.Result, initial, fn)}
+}
+
+// Send sends elements to channel
+// in normal act it sends all elements but if func canceled it can be less
+//
+// it locks execution of gorutine
+// it doesn't close channel after work
+// returns sent elements if len(this) != len(old) considered func was canceled
+func (o OfComparableSlice[T]) Send(ctx context.Context, ch chan<- T) OfComparableSlice[T] {
+	return OfComparableSlice[T]{Send(ctx, o.Result, ch)}
+}
+
+// SequenceUsing generates slice in range using creator function
+//
+// There are 3 variations to generate:
+//  1. [0, n).
+//  2. [min, max).
+//  3. [min, max) with step.
+//
+// if len(params) == 1 considered that will be returned slice between 0 and n,
+// where n is the first param, [0, n).
+// if len(params) == 2 considered that will be returned slice between min and max,
+// where min is the first param, max is the second, [min, max).
+// if len(params) > 2 considered that will be returned slice between min and max with step,
+// where min is the first param, max is the second, step is the third one, [min, max) with step,
+// others params will be ignored
+func (o OfComparableSlice[T]) SequenceUsing(creator func(int) T, params ...int) OfComparableSlice[T] {
+	return OfComparableSlice[T]{SequenceUsing(o.Result, creator, params...)}
+}
+
+// Shift will return two values: the shifted value and the rest slice.
+// if the slice is empty then returned shifted value is the zero value of the slice elements and the rest slice is empty slice
+func (o OfComparableSlice[T]) Shift() (T, []T) {
+	return Shift(o.Result)
+}
+
+// Shuffle returns a new shuffled slice by your rand.Source. The original slice
+// is not modified.
+func (o OfComparableSlice[T]) Shuffle(source rand.Source) OfComparableSlice[T] {
+	return OfComparableSlice[T]{Shuffle(o.Result, source)}
+}
+
+// ShuffleSecure returns a new shuffled slice using crypto/rand. It is suitable
+// for shuffles that must not be predictable, such as lottery draws. Each order
+// is equally likely. The original slice is not modified.
+//
+// An error is only returned if crypto/rand fails.
+func (o OfComparableSlice[T]) ShuffleSecure() ([]T, error) {
+	return ShuffleSecure(o.Result)
+}
+
+// ShuffleSeed returns a new shuffled slice that is always the same for the
+// same seed. The original slice is not modified.
+//
+// Unlike Shuffle, the order does not depend on the math/rand implementation.
+// It uses SplitMix64 for the random numbers, Lemire's method to choose each
+// index and a Fisher-Yates shuffle (swapping from the last element down). The
+// order for a seed and slice length will not change between versions of pie
+// or Go, so it is safe to use in tests or to store the seed to reproduce a
+// shuffle later.
+func (o OfComparableSlice[T]) ShuffleSeed(seed uint64) OfComparableSlice[T] {
+	return OfComparableSlice[T]{ShuffleSeed(o.Result, seed)}
+}
+
+// ShuffleWith returns a new shuffled slice by your Uint64Source. The original
+// slice is not modified. Unlike Shuffle, it does not need to create a
+// rand.Rand for each call.
+func (o OfComparableSlice[T]) ShuffleWith(source Uint64Source) OfComparableSlice[T] {
+	return OfComparableSlice[T]{ShuffleWith(o.Result, source)}
+}
+
+// SortStableUsing works similar to sort.SliceStable. However, unlike sort.SliceStable the
+// slice returned will be reallocated as to not modify the input slice.
+func (o OfComparableSlice[T]) SortStableUsing(less func(a, b T) bool) OfComparableSlice[T] {
+	return OfComparableSlice[T]{SortStableUsing(o.Result, less)}
+}
+
+// SortUsing works similar to sort.Slice. However, unlike sort.Slice the
+// slice returned will be reallocated as to not modify the input slice.
+func (o OfComparableSlice[T]) SortUsing(less func(a, b T) bool) OfComparableSlice[T] {
+	return OfComparableSlice[T]{SortUsing(o.Result, less)}
+}
+
+// StringsUsing transforms each element to a string.
+func (o OfComparableSlice[T]) StringsUsing(transform func(T) string) []string {
+	return StringsUsing(o.Result, transform)
+}
+
+// SubSlice will return the subSlice from start to end(excluded)
+//
+// Condition 1: If start < 0 or end < 0, nil is returned.
+// Condition 2: If start >= end, nil is returned.
+// Condition 3: Return all elements that exist in the range provided,
+// if start or end is out of bounds, zero items will be placed.
+func (o OfComparableSlice[T]) SubSlice(start int, end int) OfComparableSlice[T] {
+	return OfComparableSlice[T]{SubSlice(o.Result, start, end)}
+}
+
+// Table writes ss to w as a table with a header row, aligning the columns so
+// that they are easy to read in a terminal:
+//
+//	+----+------+
+//	| ID | Name |
+//	+----+------+
+//	|  1 | Bob  |
+//	| 23 | Jane |
+//	+----+------+
+//
+// If no columns are provided and T is a struct (or a pointer to a struct),
+// there will be a column for each exported field. Otherwise, there will be a
+// single column called "Value".
+//
+// Widths are counted in runes, so characters that are displayed wider (or
+// narrower) than others may not line up. New lines in cells are replaced with
+// spaces.
+func (o OfComparableSlice[T]) Table(w io.Writer, style TableStyle, columns ...TableColumn[T]) error {
+	return Table(w, o.Result, style, columns...)
+}
+
+// ToCSV writes ss to w as CSV with a header row, followed by one row for each
+// element. The header is always written, even if ss is empty.
+//
+// If no columns are provided, the columns from CSVFields are used, so T must
+// be a struct or a pointer to a struct. Otherwise, the columns are written in
+// the order they are provided:
+//
+//	pie.ToCSV(w, users,
+//		pie.CSVColumn[User]{Name: "id", Value: func(u User) string { return u.ID }},
+//		pie.CSVColumn[User]{Name: "email", Value: func(u User) string { return u.Email }},
+//	)
+//
+// A *CSVError is returned if a field from CSVFields cannot be formatted.
+func (o OfComparableSlice[T]) ToCSV(w io.Writer, columns ...CSVColumn[T]) error {
+	return ToCSV(w, o.Result, columns...)
+}
+
+// Top will return n elements from head of the slice
+// if the slice has less elements then n that'll return all elements
+// if n < 0 it'll return empty slice.
+func (o OfComparableSlice[T]) Top(n int) OfComparableSlice[T] {
+	return OfComparableSlice[T]{Top(o.Result, n)}
+}
+
+// Unique returns a new slice with all of the unique values.
+//
+// The items will be returned in a randomized order, even with the same input.
+//
+// The number of items returned may be the same as the input or less. It will
+// never return zero items unless then input slice has zero items.
+//
+// A slice with zero elements is considered to be unique.
+//
+// See AreUnique(). Use Dedup to only remove consecutive duplicates.
+func (o OfComparableSlice[T]) Unique() OfComparableSlice[T] {
+	return OfComparableSlice[T]{Unique(o.Result)}
+}
+
+// UniqueStable works similar to Unique. However, unlike Unique
+// the slice returned will be in previous relative order
+func (o OfComparableSlice[T]) UniqueStable() OfComparableSlice[T] {
+	return OfComparableSlice[T]{UniqueStable(o.Result)}
+}
+
+// Unshift adds one or more elements to the beginning of the slice
+// and returns the new slice.
+func (o OfComparableSlice[T]) Unshift(elements ...T) OfComparableSlice[T] {
+	return OfComparableSlice[T]{Unshift(o.Result, elements...)}
+}
+
+// WeightedChoice returns a random element by your rand.Source, where the chance
+// of each element being chosen is proportional to its weight. Elements with a
+// weight of zero or less are never chosen.
+//
+// If there are no elements with a positive weight, the zero value and false
+// are returned.
+func (o OfComparableSlice[T]) WeightedChoice(weight func(T) float64, source rand.Source) (T, bool) {
+	return WeightedChoice(o.Result, weight, source)
+}
+
+// WeightedSample returns k different elements chosen at random by your
+// rand.Source, where the chance of each element being chosen is proportional
+// to its weight. Elements with a weight of zero or less are never chosen, so
+// fewer than k elements are returned if there are not enough elements with a
+// positive weight.
+//
+// Like Sample, each element can only be chosen once and the chosen elements are
+// returned in the same order that they appear in ss. If k is less than 1, nil
+// is returned.
+//
+// WeightedSample uses the algorithm by Efraimidis and Spirakis, which gives
+// each element a random key based on its weight and chooses the k largest
+// keys.
+func (o OfComparableSlice[T]) WeightedSample(k int, weight func(T) float64, source rand.Source) OfComparableSlice[T] {
+	return OfComparableSlice[T]{WeightedSample(o.Result, k, weight, source)}
+}
+
+// WriteJSONLines writes each element of ss to w as a single line of JSON (also
+// known as JSON Lines or NDJSON). Elements are encoded and written one at a
+// time, so the whole output is never held in memory.
+//
+// Nothing is written for an empty slice. If an element cannot be encoded, the
+// elements before it will have already been written and the error includes the
+// index of the element.
+func (o OfComparableSlice[T]) WriteJSONLines(w io.Writer) error {
+	return WriteJSONLines(w, o.Result)
+}
+
+// Zip will return a new slice containing pairs with elements from input slices.
+// If input slices have diffrent length, the output slice will be truncated to
+// the length of the smallest input slice.
+func (o OfComparableSlice[T]) Zip(ss2 []T) []Zipped[T, T] {
+	return Zip(o.Result, ss2)
+}
+
+// Zip3 works like Zip for three slices. The output slice will be truncated to
+// the length of the smallest input slice.
+func (o OfComparableSlice[T]) Zip3(ss2 []T, ss3 []T) []Zipped3[T, T, T] {
+	return Zip3(o.Result, ss2, ss3)
+}
+
+// ZipLongest will return a new slice containing pairs with elements from input slices.
+// If input slices have diffrent length, missing elements will be padded with default values.
+func (o OfComparableSlice[T]) ZipLongest(ss2 []T) []Zipped[T, T] {
+	return ZipLongest(o.Result, ss2)
+}
+
+// ZipLongestWith works like ZipLongest, except that missing elements are
+// padded with fill1 (when ss1 is shorter) or fill2 (when ss2 is shorter)
+// instead of the zero value.
+func (o OfComparableSlice[T]) ZipLongestWith(ss2 []T, fill1 T, fill2 T) []Zipped[T, T] {
+	return ZipLongestWith(o.Result, ss2, fill1, fill2)
+}
+
+// ZipStrict works like Zip, except that it returns ErrUnequalLengths (and a nil
+// slice) if ss1 and ss2 are not the same length, rather than truncating.
+func (o OfComparableSlice[T]) ZipStrict(ss2 []T) ([]Zipped[T, T], error) {
+	return ZipStrict(o.Result, ss2)
+}
+
+// ZipWith returns a new slice containing the result of fn for each pair of
+// elements from ss1 and ss2. It is the same as mapping the result of Zip, but
+// without creating the pairs:
+//
+//	ZipWith([1, 2, 3], [10, 20], add) => [11, 22]
+//
+// If the input slices have different lengths, the output slice will be
+// truncated to the length of the smallest input slice.
+func (o OfComparableSlice[T]) ZipWith(ss2 []T, fn func(T, T) T) OfComparableSlice[T] {
+	return OfComparableSlice[T]{ZipWith(o.Result, ss2, fn)}
+}
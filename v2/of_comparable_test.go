@@ -0,0 +1,61 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type point struct {
+	X, Y int
+}
+
+func TestOfComparable(t *testing.T) {
+	points := []point{{1, 2}, {3, 4}, {1, 2}, {5, 6}}
+
+	t.Run("chaining", func(t *testing.T) {
+		contains := pie.OfComparable(points).
+			UniqueStable().
+			Contains(point{3, 4})
+
+		assert.True(t, contains)
+	})
+
+	t.Run("result", func(t *testing.T) {
+		result := pie.OfComparable(points).
+			UniqueStable().
+			Filter(func(p point) bool {
+				return p.X > 1
+			}).
+			Result
+
+		assert.Equal(t, []point{{3, 4}, {5, 6}}, result)
+	})
+
+	t.Run("mode", func(t *testing.T) {
+		assert.Equal(t, []point{{1, 2}}, pie.OfComparable(points).Mode().Result)
+	})
+
+	t.Run("intersect", func(t *testing.T) {
+		result := pie.OfComparable(points).
+			Intersect([]point{{5, 6}, {7, 8}}).
+			Result
+
+		assert.Equal(t, []point{{5, 6}}, result)
+	})
+
+	t.Run("diff", func(t *testing.T) {
+		added, removed := pie.OfComparable(points).
+			UniqueStable().
+			Diff([]point{{1, 2}, {7, 8}})
+
+		assert.Equal(t, []point{{7, 8}}, added)
+		assert.ElementsMatch(t, []point{{3, 4}, {5, 6}}, removed)
+	})
+
+	t.Run("group", func(t *testing.T) {
+		assert.Equal(t, map[point]int{{1, 2}: 2, {3, 4}: 1, {5, 6}: 1},
+			pie.OfComparable(points).Group())
+	})
+}
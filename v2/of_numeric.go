@@ -1,10 +1,12 @@
+// Code generated by go generate; DO NOT EDIT.
+
 package pie
 
 import (
 	"context"
-	"math/rand"
-
 	"golang.org/x/exp/constraints"
+	"io"
+	"math/rand"
 )
 
 // OfNumeric encapsulates a slice to be used in multiple chained operations.
@@ -21,222 +23,1402 @@ type OfNumericSlice[T constraints.Integer | constraints.Float] struct {
 	Result []T
 }
 
+// Add returns the sum of the elements at each position of ss1 and ss2:
+//
+//	Add([1, 2, 3], [10, 20, 30]) => [11, 22, 33]
+//
+// ErrUnequalLengths is returned if ss1 and ss2 are not the same length. nil is
+// returned if there are no elements.
+func (o OfNumericSlice[T]) Add(ss2 []T) ([]T, error) {
+	return Add(o.Result, ss2)
+}
+
+// All will return true if all callbacks return true. It follows the same logic
+// as the all() function in Python.
+//
+// If the list is empty then true is always returned.
 func (o OfNumericSlice[T]) All(fn func(value T) bool) bool {
 	return All(o.Result, fn)
 }
 
+// Any will return true if any callbacks return true. It follows the same logic
+// as the any() function in Python.
+//
+// If the list is empty then false is always returned.
 func (o OfNumericSlice[T]) Any(fn func(value T) bool) bool {
 	return Any(o.Result, fn)
 }
 
+// AreSorted will return true if the slice is already sorted. It is a wrapper
+// for sort.SliceIsSorted.
 func (o OfNumericSlice[T]) AreSorted() bool {
 	return AreSorted(o.Result)
 }
 
+// AreUnique will return true if the slice contains elements that are all
+// different (unique) from each other.
 func (o OfNumericSlice[T]) AreUnique() bool {
 	return AreUnique(o.Result)
 }
 
+// Average is the average of all of the elements, or zero if there are no
+// elements.
 func (o OfNumericSlice[T]) Average() float64 {
 	return Average(o.Result)
 }
 
+// Bottom will return n elements from bottom
+//
+// that means that elements is taken from the end of the slice
+// for this [1,2,3] slice with n == 2 will be returned [3,2]
+// if the slice has less elements then n that'll return all elements
+// if n < 0 it'll return empty slice.
 func (o OfNumericSlice[T]) Bottom(n int) OfNumericSlice[T] {
 	return OfNumericSlice[T]{Bottom(o.Result, n)}
 }
 
+// Bucketize returns the index of the bucket for each element, where bucket i
+// is between edges[i] and edges[i+1]. Each bucket includes the lower edge but
+// not the upper edge, except for the last bucket, which includes both:
+//
+//	Bucketize([0, 4.5, 5, 10, 11], [0, 5, 10]) => [0, 0, 1, 1, -1]
+//
+// -1 is used for elements outside of the edges and NaN. It will panic if there
+// are fewer than two edges or the edges are not sorted.
+func (o OfNumericSlice[T]) Bucketize(edges []float64) []int {
+	return Bucketize(o.Result, edges)
+}
+
+// Chunk splits the input and returns multi slices whose length equals chunkLength,
+// except for the last slice which may contain fewer elements. The chunks are
+// copied from the input, so appending to one chunk will not change the next.
+//
+// Examples:
+//
+//	Chunk([1, 2, 3], 4) => [ [1, 2, 3] ]
+//	Chunk([1, 2, 3], 3) => [ [1, 2, 3] ]
+//	Chunk([1, 2, 3], 2) => [ [1, 2], [3] ]
+//	Chunk([1, 2, 3], 1) => [ [1], [2], [3] ]
+//	Chunk([], 1)        => [ [] ]
+//	Chunk([1, 2, 3], 0) => panic: chunkLength should be greater than 0
+func (o OfNumericSlice[T]) Chunk(chunkLength int) [][]T {
+	return Chunk(o.Result, chunkLength)
+}
+
+// Clamp returns a new slice where elements less than lo are replaced with lo
+// and elements greater than hi are replaced with hi:
+//
+//	Clamp([-5, 2, 12], 0, 10) => [0, 2, 10]
+//
+// nil is returned if there are no elements in the slice. It will panic if lo is
+// greater than hi.
+func (o OfNumericSlice[T]) Clamp(lo, hi T) OfNumericSlice[T] {
+	return OfNumericSlice[T]{Clamp(o.Result, lo, hi)}
+}
+
+// Combinations returns every way to choose k elements from ss, where the order
+// does not matter. See CombinationsSeq for the order.
+//
+// ErrTooManyResults is returned (without generating any combinations) if there
+// would be more than max.
+func (o OfNumericSlice[T]) Combinations(k int, max int) ([][]T, error) {
+	return Combinations(o.Result, k, max)
+}
+
+// CombinationsSeq returns a sequence (that can be used as an iter.Seq) of
+// every way to choose k elements from ss, where the order does not matter.
+// Elements are treated as different based on their position, not their value.
+//
+// The chosen elements keep their order from ss, and the combinations are
+// produced in lexicographic order of the positions:
+//
+//	CombinationsSeq([a, b, c], 2) => [a, b], [a, c], [b, c]
+//
+// Nothing is produced if k is negative or greater than the length of ss. They
+// are generated lazily and each one is a new slice.
+func (o OfNumericSlice[T]) CombinationsSeq(k int) func(yield func([]T) bool) {
+	return CombinationsSeq(o.Result, k)
+}
+
+// CombinationsWithReplacement returns every way to choose k elements from ss,
+// where the order does not matter and each element can be chosen more than
+// once. See CombinationsWithReplacementSeq for the order.
+//
+// ErrTooManyResults is returned (without generating any combinations) if there
+// would be more than max.
+func (o OfNumericSlice[T]) CombinationsWithReplacement(k int, max int) ([][]T, error) {
+	return CombinationsWithReplacement(o.Result, k, max)
+}
+
+// CombinationsWithReplacementSeq returns a sequence (that can be used as an
+// iter.Seq) of every way to choose k elements from ss, where the order does
+// not matter and each element can be chosen more than once:
+//
+//	CombinationsWithReplacementSeq([a, b, c], 2) => [a, a], [a, b], [a, c], [b, b], [b, c], [c, c]
+//
+// Nothing is produced if k is negative, or if ss is empty and k is greater
+// than zero. They are generated lazily and each one is a new slice.
+func (o OfNumericSlice[T]) CombinationsWithReplacementSeq(k int) func(yield func([]T) bool) {
+	return CombinationsWithReplacementSeq(o.Result, k)
+}
+
+// Compact returns a new slice with all of the zero values (such as 0, "" or
+// nil) removed. The order of the remaining elements is retained.
+//
+// The returned slice may contain zero elements (nil).
+//
+// Compact is not the same as slices.Compact from the standard library, which
+// removes consecutive duplicates.
+func (o OfNumericSlice[T]) Compact() OfNumericSlice[T] {
+	return OfNumericSlice[T]{Compact(o.Result)}
+}
+
+// Contains returns true if the element exists in the slice.
+//
+// When using slices of pointers it will only compare by address, not value.
 func (o OfNumericSlice[T]) Contains(lookingFor T) bool {
 	return Contains(o.Result, lookingFor)
 }
 
+// Covariance is the population covariance of xs and ys, which is positive when
+// they tend to increase together and negative when one tends to decrease as the
+// other increases. Like Stddev, it divides by the number of elements.
+//
+// ErrUnequalLengths is returned if xs and ys are not the same length. Zero is
+// returned if there are no elements.
+func (o OfNumericSlice[T]) Covariance(ys []T) (float64, error) {
+	return Covariance(o.Result, ys)
+}
+
+// CumulativeDot returns the running dot product of ss1 and ss2. Each value is
+// the Dot of the elements up to and including that position, in the same way
+// as CumulativeSum:
+//
+//	CumulativeDot([1, 2, 3], [4, 5, 6]) => [4, 14, 32]
+//
+// ErrUnequalLengths is returned if ss1 and ss2 are not the same length. nil is
+// returned if there are no elements.
+func (o OfNumericSlice[T]) CumulativeDot(ss2 []T) ([]T, error) {
+	return CumulativeDot(o.Result, ss2)
+}
+
+// CumulativeMax returns the running maximum of the elements. Each value is the
+// largest of the element at the same position and all of the elements before
+// it.
+//
+// nil is returned if there are no elements in the slice.
+//
+// Examples:
+//
+//	CumulativeMax([3, 1, 4, 1, 5]) => [3, 3, 4, 4, 5]
+func (o OfNumericSlice[T]) CumulativeMax() OfNumericSlice[T] {
+	return OfNumericSlice[T]{CumulativeMax(o.Result)}
+}
+
+// CumulativeProduct returns the running product of the elements. Each value is
+// the product of the element at the same position and all of the elements
+// before it.
+//
+// nil is returned if there are no elements in the slice.
+//
+// Examples:
+//
+//	CumulativeProduct([1, 2, 3, 4]) => [1, 2, 6, 24]
+func (o OfNumericSlice[T]) CumulativeProduct() OfNumericSlice[T] {
+	return OfNumericSlice[T]{CumulativeProduct(o.Result)}
+}
+
+// CumulativeSum returns the running total of the elements. Each value is the
+// sum of the element at the same position and all of the elements before it.
+//
+// nil is returned if there are no elements in the slice.
+//
+// Examples:
+//
+//	CumulativeSum([1, 2, 3, 4]) => [1, 3, 6, 10]
+func (o OfNumericSlice[T]) CumulativeSum() OfNumericSlice[T] {
+	return OfNumericSlice[T]{CumulativeSum(o.Result)}
+}
+
+// Dedup returns a new slice where each run of consecutive equal elements is
+// replaced with the first element of the run. Unlike Unique, elements that are
+// equal but not next to each other are kept, and the order is unchanged:
+//
+//	Dedup([a, a, b, b, b, a, c]) => [a, b, a, c]
+//
+// Sort the slice first to remove all duplicates. See inplace.Dedup to modify
+// the slice instead of allocating a new one.
+func (o OfNumericSlice[T]) Dedup() OfNumericSlice[T] {
+	return OfNumericSlice[T]{Dedup(o.Result)}
+}
+
+// Removes elements at indices in idx from input slice, returns resulting slice.
+// If an index is out of bounds, skip it.
+func (o OfNumericSlice[T]) Delete(idx ...int) OfNumericSlice[T] {
+	return OfNumericSlice[T]{Delete(o.Result, idx...)}
+}
+
+// Diff returns the elements that needs to be added or removed from the first
+// slice to have the same elements in the second slice.
+//
+// The order of elements is not taken into consideration, so the slices are
+// treated sets that allow duplicate items.
+//
+// The added and removed returned may be blank respectively, or contain upto as
+// many elements that exists in the largest slice.
 func (o OfNumericSlice[T]) Diff(against []T) ([]T, []T) {
 	return Diff(o.Result, against)
 }
 
+// Diffs returns the differences between adjacent elements, that is
+// ss[i+1]-ss[i] for each position. The result has one less element than the
+// input.
+//
+// nil is returned if there are less than two elements in the slice.
+//
+// Diffs is the inverse of CumulativeSum, apart from the first element.
+//
+// Examples:
+//
+//	Diffs([1, 3, 6, 10]) => [2, 3, 4]
+//	Diffs([5, 2])        => [-3]
+//	Diffs([5])           => []
+func (o OfNumericSlice[T]) Diffs() OfNumericSlice[T] {
+	return OfNumericSlice[T]{Diffs(o.Result)}
+}
+
+// Divide returns the elements of ss1 divided by the element at the same position
+// of ss2:
+//
+//	Divide([10, 20, 30], [2, 4, 5]) => [5, 5, 6]
+//
+// ErrUnequalLengths is returned if ss1 and ss2 are not the same length. nil is
+// returned if there are no elements.
+//
+// Like the / operator, integers are truncated and dividing an integer by zero
+// will panic. Dividing a float by zero gives an infinity (or NaN for 0/0).
+func (o OfNumericSlice[T]) Divide(ss2 []T) ([]T, error) {
+	return Divide(o.Result, ss2)
+}
+
+// Dot returns the dot product of ss1 and ss2, which is the sum of the products
+// of the elements at each position:
+//
+//	Dot([1, 2, 3], [4, 5, 6]) => 32
+//
+// ErrUnequalLengths is returned if ss1 and ss2 are not the same length. Zero is
+// returned if there are no elements.
+//
+// The products are added to four separate totals that are combined at the end.
+// This is about twice as fast as a single total (see BenchmarkVectorDot), but
+// for floats the result may be slightly different from adding them in order.
+func (o OfNumericSlice[T]) Dot(ss2 []T) (T, error) {
+	return Dot(o.Result, ss2)
+}
+
+// DropTop will return the rest slice after dropping the top n elements
+// if the slice has less elements then n that'll return empty slice
+// if n < 0 it'll return empty slice.
 func (o OfNumericSlice[T]) DropTop(n int) OfNumericSlice[T] {
 	return OfNumericSlice[T]{DropTop(o.Result, n)}
 }
 
+// Drop items from the slice while f(item) is true.
+// Afterwards, return every element until the slice is empty. It follows the
+// same logic as the dropwhile() function from itertools in Python.
 func (o OfNumericSlice[T]) DropWhile(f func(s T) bool) OfNumericSlice[T] {
 	return OfNumericSlice[T]{DropWhile(o.Result, f)}
 }
 
+// Each is more condensed version of Transform that allows an action to happen
+// on each elements and pass a copy of the slice on.
+//
+//	pie.Each(cars, func (car *Car) {
+//	    fmt.Printf("Car color is: %s\n", car.Color)
+//	})
+//
+// Pie will not ensure immutability on items passed in so they can be
+// manipulated, if you choose to do it this way, for example:
+//
+//	// Set all car colors to Red.
+//	pie.Each(cars, func (car *Car) {
+//	    car.Color = "Red"
+//	})
 func (o OfNumericSlice[T]) Each(fn func(T)) OfNumericSlice[T] {
 	return OfNumericSlice[T]{Each(o.Result, fn)}
 }
 
+// Enumerate returns a new slice of pairs, where A is the index and B is the
+// element of ss at that index:
+//
+//	Enumerate([a, b, c]) => [{0, a}, {1, b}, {2, c}]
+func (o OfNumericSlice[T]) Enumerate() []Zipped[int, T] {
+	return Enumerate(o.Result)
+}
+
+// Equals compare elements from the start to the end,
+//
+// if they are the same is considered the slices are equal if all elements are
+// the same is considered the slices are equal
+// if each slice == nil is considered that they're equal
+//
+// if element realizes Equals interface it uses that method, in other way uses
+// default compare
 func (o OfNumericSlice[T]) Equals(rhs []T) bool {
 	return Equals(o.Result, rhs)
 }
 
+// ExponentialMovingAverage returns the exponential moving average of the
+// elements, using alpha as the smoothing factor.
+//
+// The first value is the first element and each subsequent value is:
+//
+//	alpha*ss[i] + (1-alpha)*previous
+//
+// A larger alpha discounts older elements faster. An alpha of 1 returns the
+// elements unchanged. It will panic if alpha is not in the range (0, 1].
+//
+// nil is returned if there are no elements in the slice.
+func (o OfNumericSlice[T]) ExponentialMovingAverage(alpha float64) []float64 {
+	return ExponentialMovingAverage(o.Result, alpha)
+}
+
+// Filter will return a new slice containing only the elements that return
+// true from the condition. The returned slice may contain zero elements (nil).
+//
+// FilterNot works in the opposite way of Filter.
 func (o OfNumericSlice[T]) Filter(condition func(T) bool) OfNumericSlice[T] {
 	return OfNumericSlice[T]{Filter(o.Result, condition)}
 }
 
+// FilterMap maps and filters in a single pass. The function returns the mapped
+// value and whether it should be included in the result.
+//
+// The returned slice may contain zero elements (nil).
+//
+// For example, parsing only the valid numbers:
+//
+//	pie.FilterMap([]string{"1", "x", "3"}, func(s string) (int, bool) {
+//	    i, err := strconv.Atoi(s)
+//	    return i, err == nil
+//	}) // [1 3]
+func (o OfNumericSlice[T]) FilterMap(fn func(T) (T, bool)) OfNumericSlice[T] {
+	return OfNumericSlice[T]{FilterMap(o.Result, fn)}
+}
+
+// FilterNot works the same as Filter, with a negated condition. That is, it will
+// return a new slice only containing the elements that returned false from the
+// condition. The returned slice may contain zero elements (nil).
 func (o OfNumericSlice[T]) FilterNot(condition func(T) bool) OfNumericSlice[T] {
 	return OfNumericSlice[T]{FilterNot(o.Result, condition)}
 }
 
+// FindFirstUsing will return the index of the first element when the callback
+// returns true or -1 if no element is found.
+// It follows the same logic as the findIndex() function in Javascript.
+//
+// If the list is empty then -1 is always returned.
 func (o OfNumericSlice[T]) FindFirstUsing(fn func(value T) bool) int {
 	return FindFirstUsing(o.Result, fn)
 }
 
+// First returns the first element or a zero value if there are no elements.
 func (o OfNumericSlice[T]) First() T {
 	return First(o.Result)
 }
 
+// FirstOr returns the first element or a default value if there are no
+// elements.
 func (o OfNumericSlice[T]) FirstOr(defaultValue T) T {
 	return FirstOr(o.Result, defaultValue)
 }
 
+// FlatMap maps each element to a slice and concatenates the results into a
+// single slice. It is equivalent to Flat(Map(ss, fn)) without the intermediate
+// two-dimensional slice.
+//
+// The returned slice may contain zero elements (nil).
+//
+// Examples:
+//
+//	FlatMap(["a b", "c"], strings.Fields) => ["a", "b", "c"]
+func (o OfNumericSlice[T]) FlatMap(fn func(T) []T) OfNumericSlice[T] {
+	return OfNumericSlice[T]{FlatMap(o.Result, fn)}
+}
+
+// Float64s transforms each element to a float64.
 func (o OfNumericSlice[T]) Float64s() []float64 {
 	return Float64s(o.Result)
 }
 
+// Fold applies fn to each element from left to right, threading an
+// accumulator that starts as initial. Unlike Reduce, the accumulator may be a
+// different type to the elements.
+//
+// initial is returned if there are no elements in the slice.
+//
+// For example, summarising orders without converting them first:
+//
+//	total := pie.Fold(orders, 0.0, func(total float64, o Order) float64 {
+//	    return total + o.Amount
+//	})
+func (o OfNumericSlice[T]) Fold(initial T, fn func(T, T) T) T {
+	return Fold(o.Result, initial, fn)
+}
+
+// FoldRight is the same as Fold except the elements are visited from right to
+// left, starting at the last element.
+//
+// initial is returned if there are no elements in the slice.
+func (o OfNumericSlice[T]) FoldRight(initial T, fn func(T, T) T) T {
+	return FoldRight(o.Result, initial, fn)
+}
+
+// GeometricMean is the nth root of the product of the n elements. It is
+// suited to values that are multiplied together, such as growth rates.
+//
+// It is calculated from the logarithms of the elements so that the product
+// cannot overflow. Zero is returned if there are no elements or any element is
+// zero. NaN is returned if any element is negative.
+func (o OfNumericSlice[T]) GeometricMean() float64 {
+	return GeometricMean(o.Result)
+}
+
+// Group returns a map of the value with an individual count.
 func (o OfNumericSlice[T]) Group() map[T]int {
 	return Group(o.Result)
 }
 
+// HarmonicMean is the number of elements divided by the sum of the reciprocals
+// of the elements. It is suited to rates, such as the average speed over equal
+// distances.
+//
+// Zero is returned if there are no elements or any element is zero. NaN is
+// returned if any element is negative.
+func (o OfNumericSlice[T]) HarmonicMean() float64 {
+	return HarmonicMean(o.Result)
+}
+
+// Histogram counts the elements in bins of equal width between the smallest
+// and largest element. NaN and infinite values are not counted.
+//
+// If all of the elements are the same value, the bins cover the range of 0.5
+// either side of the value. nil is returned if there are no (finite) elements.
+// It will panic if bins is less than 1.
+//
+// See HistogramEdges and HistogramQuantiles for other ways to choose the bins.
+func (o OfNumericSlice[T]) Histogram(bins int) []HistogramBin {
+	return Histogram(o.Result, bins)
+}
+
+// HistogramEdges counts the elements in the bins between each of the edges,
+// so there is one less bin than edges:
+//
+//	HistogramEdges([1, 2, 5, 10, 11], [0, 5, 10]) => [{0, 5, 2}, {5, 10, 2}]
+//
+// Elements outside of the edges (and NaN) are not counted. See Bucketize for
+// how the elements are assigned to bins. It will panic if there are fewer than
+// two edges or the edges are not sorted.
+func (o OfNumericSlice[T]) HistogramEdges(edges []float64) []HistogramBin {
+	return HistogramEdges(o.Result, edges)
+}
+
+// HistogramQuantiles counts the elements in bins that each contain about the
+// same number of elements. The edges are the quantiles of the elements (using
+// linear interpolation), so the bins are narrower where the elements are
+// closer together.
+//
+// Repeated values can result in bins with a width of zero, which will be empty
+// unless it is the last bin. NaN and infinite values are not counted. nil is
+// returned if there are no (finite) elements. It will panic if bins is less
+// than 1.
+func (o OfNumericSlice[T]) HistogramQuantiles(bins int) []HistogramBin {
+	return HistogramQuantiles(o.Result, bins)
+}
+
+// Insert a value at an index. If the index is greater than or equal to the
+// length of the slice, the values are appended to the end.
 func (o OfNumericSlice[T]) Insert(index int, values ...T) OfNumericSlice[T] {
 	return OfNumericSlice[T]{Insert(o.Result, index, values...)}
 }
 
+// Intersect returns items that exist in all lists.
+//
+// It returns slice without any duplicates.
+// If zero slice arguments are provided, then nil is returned.
 func (o OfNumericSlice[T]) Intersect(slices ...[]T) OfNumericSlice[T] {
 	return OfNumericSlice[T]{Intersect(o.Result, slices...)}
 }
 
+// Ints transforms each element to an integer.
 func (o OfNumericSlice[T]) Ints() []int {
 	return Ints(o.Result)
 }
 
-func (o OfNumericSlice[T]) Join(glue string) string {
-	return Join(o.Result, glue)
-}
-
+// JSONBytes returns the JSON encoded array as bytes.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
+//
+// The error from the encoder is ignored (nil is returned for a NaN or infinite
+// float). Use JSONMarshal to encode other element types or to check for errors.
 func (o OfNumericSlice[T]) JSONBytes() []byte {
 	return JSONBytes(o.Result)
 }
 
+// JSONBytesIndent returns the JSON encoded array as bytes with indent applied.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array. See
+// json.MarshalIndent for details.
+//
+// The error from the encoder is ignored (nil is returned for a NaN or infinite
+// float). Use JSONMarshalIndent to encode other element types or to check for
+// errors.
 func (o OfNumericSlice[T]) JSONBytesIndent(prefix, indent string) []byte {
 	return JSONBytesIndent(o.Result, prefix, indent)
 }
 
+// JSONMarshal returns the JSON encoded array. Unlike JSONBytes, it works with
+// any element type (such as structs and pointers) and returns the error from
+// the encoder, for example for a NaN float or a failing json.Marshaler.
+//
+// Like JSONBytes, a nil slice is encoded as an empty array rather than null.
+func (o OfNumericSlice[T]) JSONMarshal() ([]byte, error) {
+	return JSONMarshal(o.Result)
+}
+
+// JSONMarshalIndent works like JSONMarshal, but applies prefix and indent in
+// the same way as json.MarshalIndent.
+func (o OfNumericSlice[T]) JSONMarshalIndent(prefix, indent string) ([]byte, error) {
+	return JSONMarshalIndent(o.Result, prefix, indent)
+}
+
+// JSONString returns the JSON encoded array as a string.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array.
 func (o OfNumericSlice[T]) JSONString() string {
 	return JSONString(o.Result)
 }
 
+// JSONStringIndent returns the JSON encoded array as a string with indent applied.
+//
+// One important thing to note is that it will treat a nil slice as an empty
+// slice to ensure that the JSON value return is always an array. See
+// json.MarshalIndent for details.
 func (o OfNumericSlice[T]) JSONStringIndent(prefix, indent string) string {
 	return JSONStringIndent(o.Result, prefix, indent)
 }
 
+// Join returns a string from joining each of the elements.
+func (o OfNumericSlice[T]) Join(glue string) string {
+	return Join(o.Result, glue)
+}
+
+// Last returns the last element or a zero value if there are no elements.
 func (o OfNumericSlice[T]) Last() T {
 	return Last(o.Result)
 }
 
+// LastOr returns the last element or a default value if there are no elements.
 func (o OfNumericSlice[T]) LastOr(defaultValue T) T {
 	return LastOr(o.Result, defaultValue)
 }
 
+// LinearRegression finds the straight line that best fits the points (xs[i],
+// ys[i]) using ordinary least squares.
+//
+// ErrUnequalLengths is returned if xs and ys are not the same length. If there
+// are no elements or all of the xs are the same, there is no single line, so
+// every value is NaN. If all of the ys are the same, the line is flat and
+// RSquared is 1.
+func (o OfNumericSlice[T]) LinearRegression(ys []T) (LinearFit, error) {
+	return LinearRegression(o.Result, ys)
+}
+
+// LongestRun returns the longest run of consecutive equal elements. If there is
+// more than one run with the longest length, the first one is returned:
+//
+//	LongestRun([a, b, b, c, c]) => {b, 2}
+//
+// The Count is zero if there are no elements.
+func (o OfNumericSlice[T]) LongestRun() Run[T] {
+	return LongestRun(o.Result)
+}
+
+// Map will return a new slice where each element has been mapped (transformed).
+// The number of elements returned will always be the same as the input.
+//
+// Be careful when using this with slices of pointers. If you modify the input
+// value it will affect the original slice. Be sure to return a new allocated
+// object or deep copy the existing one.
+//
+// The element type can be changed by the mapping. Since Go methods cannot
+// introduce type parameters, the Map method of OfSlice (and friends) must
+// return the same type. To continue a chain after changing the type, pass the
+// Result through Map and wrap it again:
+//
+//	ids := pie.OfOrdered(pie.Map(pie.Of(users).Filter(isActive).Result, User.ID)).
+//	    Unique().
+//	    Result
+//
+// See also MapIndexed, FlatMap and FilterMap.
 func (o OfNumericSlice[T]) Map(fn func(T) T) OfNumericSlice[T] {
 	return OfNumericSlice[T]{Map(o.Result, fn)}
 }
 
+// MapIndexed is the same as Map except the function also receives the index of
+// each element.
+//
+// The number of elements returned will always be the same as the input.
+func (o OfNumericSlice[T]) MapIndexed(fn func(int, T) T) OfNumericSlice[T] {
+	return OfNumericSlice[T]{MapIndexed(o.Result, fn)}
+}
+
+// Max is the maximum value, or zero.
 func (o OfNumericSlice[T]) Max() T {
 	return Max(o.Result)
 }
 
+// Median returns the value separating the higher half from the lower half of a
+// data sample.
+//
+// Zero is returned if there are no elements in the slice.
+//
+// If the number of elements is even, then the ElementType mean of the two
+// "median values" is returned.
 func (o OfNumericSlice[T]) Median() T {
 	return Median(o.Result)
 }
 
+// MedianAbsoluteDeviation is the median of the absolute differences between
+// each element and the Median of the elements. It is a measure of how spread
+// out the elements are, like Stddev, but is not affected by a few outliers.
+//
+// Unlike Median, the result is a float64 (even for integers) so the median is
+// not rounded. Multiply the result by 1.4826 to estimate the standard deviation
+// of normally distributed data.
+//
+// Zero is returned if there are no elements.
+func (o OfNumericSlice[T]) MedianAbsoluteDeviation() float64 {
+	return MedianAbsoluteDeviation(o.Result)
+}
+
+// Min is the minimum value, or zero.
 func (o OfNumericSlice[T]) Min() T {
 	return Min(o.Result)
 }
 
+// MinMaxScale scales the elements to the range [0, 1], where the smallest
+// element becomes 0 and the largest becomes 1:
+//
+//	MinMaxScale([10, 15, 20]) => [0, 0.5, 1]
+//
+// If all of the elements are the same (so there is no range to scale), every
+// value is 0. nil is returned if there are no elements in the slice.
+func (o OfNumericSlice[T]) MinMaxScale() []float64 {
+	return MinMaxScale(o.Result)
+}
+
+// Mode returns a new slice containing the most frequently occuring values.
+//
+// The number of items returned may be the same as the input or less. It will
+// never return zero items unless the input slice has zero items.
 func (o OfNumericSlice[T]) Mode() OfNumericSlice[T] {
 	return OfNumericSlice[T]{Mode(o.Result)}
 }
 
+// Multiply returns the product of the elements at each position of ss1 and ss2
+// (the Hadamard product):
+//
+//	Multiply([1, 2, 3], [10, 20, 30]) => [10, 40, 90]
+//
+// ErrUnequalLengths is returned if ss1 and ss2 are not the same length. nil is
+// returned if there are no elements.
+func (o OfNumericSlice[T]) Multiply(ss2 []T) ([]T, error) {
+	return Multiply(o.Result, ss2)
+}
+
+// Norm returns the Euclidean length (the L2 norm) of ss, which is the square
+// root of the sum of the squares of the elements:
+//
+//	Norm([3, 4]) => 5
+//
+// The squares are added as float64s, so integers cannot overflow. Like Dot,
+// they are added to four separate totals. Zero is returned if there are no
+// elements. See NormalizeL2 to scale a slice to a length of 1.
+func (o OfNumericSlice[T]) Norm() float64 {
+	return Norm(o.Result)
+}
+
+// NormalizeL1 divides each element by the sum of the absolute values of the
+// elements (the L1 norm), so that the absolute values add up to 1:
+//
+//	NormalizeL1([1, -3, 4]) => [0.125, -0.375, 0.5]
+//
+// If every element is zero, every value is 0. nil is returned if there are no
+// elements in the slice.
+func (o OfNumericSlice[T]) NormalizeL1() []float64 {
+	return NormalizeL1(o.Result)
+}
+
+// NormalizeL2 divides each element by the Euclidean length of the elements
+// (the L2 norm), so that the result is a unit vector:
+//
+//	NormalizeL2([3, 4]) => [0.6, 0.8]
+//
+// If every element is zero, every value is 0. nil is returned if there are no
+// elements in the slice.
+func (o OfNumericSlice[T]) NormalizeL2() []float64 {
+	return NormalizeL2(o.Result)
+}
+
+// PearsonCorrelation is the Pearson correlation coefficient of xs and ys. It
+// measures how close they are to having a linear relationship, from -1 (as one
+// increases the other decreases) to 1 (they increase together). Zero means
+// there is no linear relationship.
+//
+// ErrUnequalLengths is returned if xs and ys are not the same length. NaN is
+// returned if either slice has no variation (including when there are fewer
+// than two elements).
+func (o OfNumericSlice[T]) PearsonCorrelation(ys []T) (float64, error) {
+	return PearsonCorrelation(o.Result, ys)
+}
+
+// Permutations returns every ordering of the elements in ss. See
+// PermutationsSeq for the order.
+//
+// There are n! permutations, so ErrTooManyResults is returned (without
+// generating any permutations) if there would be more than max.
+func (o OfNumericSlice[T]) Permutations(max int) ([][]T, error) {
+	return Permutations(o.Result, max)
+}
+
+// PermutationsSeq returns a sequence (that can be used as an iter.Seq) of every
+// ordering of the elements in ss. Elements are treated as different based on
+// their position, not their value, so equal elements produce repeated
+// permutations.
+//
+// The permutations are produced in lexicographic order of the positions:
+//
+//	PermutationsSeq([a, b, c]) => [a, b, c], [a, c, b], [b, a, c], [b, c, a], ...
+//
+// There are n! permutations, including a single empty permutation if ss is
+// empty. They are generated lazily and each one is a new slice.
+func (o OfNumericSlice[T]) PermutationsSeq() func(yield func([]T) bool) {
+	return PermutationsSeq(o.Result)
+}
+
+// Pop the first element of the slice
+//
+// Usage Example:
+//
+//	type knownGreetings []string
+//	greetings := knownGreetings{"ciao", "hello", "hola"}
+//	for greeting := greetings.Pop(); greeting != nil; greeting = greetings.Pop() {
+//	    fmt.Println(*greeting)
+//	}
+func (o *OfNumericSlice[T]) Pop() *T {
+	return Pop(&o.Result)
+}
+
+// PowerSet returns every subset of ss. See PowerSetSeq for the order.
+//
+// There are 2^n subsets, so ErrTooManyResults is returned (without generating
+// any subsets) if there would be more than max.
+func (o OfNumericSlice[T]) PowerSet(max int) ([][]T, error) {
+	return PowerSet(o.Result, max)
+}
+
+// PowerSetSeq returns a sequence (that can be used as an iter.Seq) of every
+// subset of ss, starting with the empty subset. The subsets are produced in
+// order of their size, and then in the same order as CombinationsSeq:
+//
+//	PowerSetSeq([a, b, c]) => [], [a], [b], [c], [a, b], [a, c], [b, c], [a, b, c]
+//
+// There are 2^n subsets. They are generated lazily and each one is a new slice.
+func (o OfNumericSlice[T]) PowerSetSeq() func(yield func([]T) bool) {
+	return PowerSetSeq(o.Result)
+}
+
+// Product is the product of all of the elements.
 func (o OfNumericSlice[T]) Product() T {
 	return Product(o.Result)
 }
 
-func (o OfNumericSlice[T]) Random(source rand.Source) T {
+// Random returns a random element by your rand.Source. If the slice is empty,
+// the zero value and false are returned.
+func (o OfNumericSlice[T]) Random(source rand.Source) (T, bool) {
 	return Random(o.Result, source)
 }
 
+// Reduce continually applies the provided function
+// over the slice. Reducing the elements to a single value.
+//
+// Returns a zero value of T if there are no elements in the slice. It will
+// panic if the reducer is nil and the slice has more than one element (required
+// to invoke reduce). Otherwise returns result of applying reducer from left to
+// right.
+//
+// Use ReduceOk to distinguish an empty slice from a zero result, or Fold to
+// reduce into a different type with an explicit initial value.
 func (o OfNumericSlice[T]) Reduce(reducer func(T, T) T) T {
 	return Reduce(o.Result, reducer)
 }
 
+// ReduceOk is the same as Reduce except that it also returns false if there
+// are no elements in the slice. This allows a legitimate zero value result to
+// be distinguished from an empty input.
+func (o OfNumericSlice[T]) ReduceOk(reducer func(T, T) T) (T, bool) {
+	return ReduceOk(o.Result, reducer)
+}
+
+// ReduceRight is the same as Reduce except the elements are reduced from right
+// to left. The last element is used as the initial value.
+//
+// Returns a zero value of T if there are no elements in the slice.
+func (o OfNumericSlice[T]) ReduceRight(reducer func(T, T) T) T {
+	return ReduceRight(o.Result, reducer)
+}
+
+// Reverse returns a new copy of the slice with the elements ordered in reverse.
+// This is useful when combined with Sort to get a descending sort order:
+//
+//	ss.Sort().Reverse()
 func (o OfNumericSlice[T]) Reverse() OfNumericSlice[T] {
 	return OfNumericSlice[T]{Reverse(o.Result)}
 }
 
+// RollingMax returns the maximum value of each window of consecutive elements.
+//
+// The result contains one value for every complete window, that is
+// len(ss)-window+1 values. nil is returned if the slice has fewer elements
+// than the window. It will panic if window is less than 1.
+//
+// This runs in O(n) regardless of the window size.
+//
+// Examples:
+//
+//	RollingMax([4, 2, 12, 3, 8], 2) => [4, 12, 12, 8]
+//	RollingMax([4, 2, 12, 3, 8], 3) => [12, 12, 12]
+func (o OfNumericSlice[T]) RollingMax(window int) OfNumericSlice[T] {
+	return OfNumericSlice[T]{RollingMax(o.Result, window)}
+}
+
+// RollingMean returns the average of each window of consecutive elements. This
+// is also known as the simple moving average.
+//
+// The result contains one value for every complete window, that is
+// len(ss)-window+1 values. nil is returned if the slice has fewer elements
+// than the window. It will panic if window is less than 1.
+//
+// Examples:
+//
+//	RollingMean([1, 2, 3, 4, 5], 2) => [1.5, 2.5, 3.5, 4.5]
+//	RollingMean([1, 2, 3, 4, 5], 5) => [3]
+func (o OfNumericSlice[T]) RollingMean(window int) []float64 {
+	return RollingMean(o.Result, window)
+}
+
+// RollingMedian returns the median of each window of consecutive elements. See
+// Median for how the median is calculated for an even sized window.
+//
+// The result contains one value for every complete window, that is
+// len(ss)-window+1 values. nil is returned if the slice has fewer elements
+// than the window. It will panic if window is less than 1.
+//
+// The median of a window that contains NaN is NaN.
+//
+// Examples:
+//
+//	RollingMedian([1, 5, 2, 8, 3], 3) => [2, 5, 3]
+//	RollingMedian([1, 5, 2, 8, 3], 2) => [3, 3, 5, 5]
+func (o OfNumericSlice[T]) RollingMedian(window int) OfNumericSlice[T] {
+	return OfNumericSlice[T]{RollingMedian(o.Result, window)}
+}
+
+// RollingMin returns the minimum value of each window of consecutive elements.
+//
+// The result contains one value for every complete window, that is
+// len(ss)-window+1 values. nil is returned if the slice has fewer elements
+// than the window. It will panic if window is less than 1.
+//
+// This runs in O(n) regardless of the window size.
+//
+// Examples:
+//
+//	RollingMin([4, 2, 12, 3, 8], 2) => [2, 2, 3, 3]
+//	RollingMin([4, 2, 12, 3, 8], 3) => [2, 2, 3]
+func (o OfNumericSlice[T]) RollingMin(window int) OfNumericSlice[T] {
+	return OfNumericSlice[T]{RollingMin(o.Result, window)}
+}
+
+// RollingSum returns the sum of each window of consecutive elements.
+//
+// The result contains one value for every complete window, that is
+// len(ss)-window+1 values. nil is returned if the slice has fewer elements
+// than the window. It will panic if window is less than 1.
+//
+// Examples:
+//
+//	RollingSum([1, 2, 3, 4, 5], 2) => [3, 5, 7, 9]
+//	RollingSum([1, 2, 3, 4, 5], 3) => [6, 9, 12]
+//	RollingSum([1, 2], 3)          => []
+func (o OfNumericSlice[T]) RollingSum(window int) OfNumericSlice[T] {
+	return OfNumericSlice[T]{RollingSum(o.Result, window)}
+}
+
+// Rotate return slice circularly rotated by a number of positions n.
+// If n is positive, the slice is rotated right.
+// If n is negative, the slice is rotated left.
+func (o OfNumericSlice[T]) Rotate(n int) OfNumericSlice[T] {
+	return OfNumericSlice[T]{Rotate(o.Result, n)}
+}
+
+// Round returns a new slice with each element rounded to the number of decimal
+// places, with halves rounded away from zero:
+//
+//	Round([1.234, 5.678, -2.5], 1) => [1.2, 5.7, -2.5]
+//	Round([1.234, 5.678, -2.5], 0) => [1, 6, -3]
+//
+// A negative number of places rounds to the left of the decimal point, so it
+// also works for integers:
+//
+//	Round([1234, 1250, -1250], -2) => [1200, 1300, -1300]
+//
+// Integers are unchanged when places is zero or more. Rounded integers that
+// are too large for T wrap around, the same as other integer arithmetic. nil is
+// returned if there are no elements in the slice.
+func (o OfNumericSlice[T]) Round(places int) OfNumericSlice[T] {
+	return OfNumericSlice[T]{Round(o.Result, places)}
+}
+
+// RunLengthEncode returns a Run for each run of consecutive equal elements:
+//
+//	RunLengthEncode([a, a, b, a, a, a]) => [{a, 2}, {b, 1}, {a, 3}]
+//
+// Use RunLengthDecode to get the original elements back. nil is returned if
+// there are no elements.
+func (o OfNumericSlice[T]) RunLengthEncode() []Run[T] {
+	return RunLengthEncode(o.Result)
+}
+
+// Sample returns k different elements chosen at random by your rand.Source.
+// Each element can only be chosen once, although equal values may appear more
+// than once if they are in the input more than once.
+//
+// The chosen elements are returned in the same order that they appear in ss.
+// If k is greater than or equal to the length of ss a copy of ss is returned,
+// and if k is less than 1 nil is returned.
+//
+// Sample uses Floyd's algorithm, so it only needs k random numbers regardless
+// of the length of ss.
+func (o OfNumericSlice[T]) Sample(k int, source rand.Source) OfNumericSlice[T] {
+	return OfNumericSlice[T]{Sample(o.Result, k, source)}
+}
+
+// SampleWithReplacement returns k elements chosen at random by your
+// rand.Source. Unlike Sample, the same element may be chosen more than once and
+// k can be greater than the length of ss.
+//
+// If ss is empty or k is less than 1, nil is returned.
+func (o OfNumericSlice[T]) SampleWithReplacement(k int, source rand.Source) OfNumericSlice[T] {
+	return OfNumericSlice[T]{SampleWithReplacement(o.Result, k, source)}
+}
+
+// Scale returns a new slice with each element multiplied by factor:
+//
+//	Scale([1, 2, 3], 10) => [10, 20, 30]
+//
+// nil is returned if there are no elements.
+func (o OfNumericSlice[T]) Scale(factor T) OfNumericSlice[T] {
+	return OfNumericSlice[T]{Scale(o.Result, factor)}
+}
+
+// Scan is like Reduce, but returns every intermediate accumulator rather than
+// only the final one. The accumulator starts at initial and may be a different
+// type to the elements.
+//
+// The result has the same length as ss, where each value is the accumulator
+// after applying fn to the element at the same position. The initial value is
+// not included. nil is returned if there are no elements in the slice.
+//
+// Examples:
+//
+//	Scan([1, 2, 3], 10, +)                  => [11, 13, 16]
+//	Scan(["a", "b", "c"], "", concatenate)  => ["a", "ab", "abc"]
+func (o OfNumericSlice[T]) Scan(initial T, fn func(T, T) T) OfNumericSlice[T] {
+	return OfNumericSlice[T]{Scan(o.Result, initial, fn)}
+}
+
+// Send sends elements to channel
+// in normal act it sends all elements but if func canceled it can be less
+//
+// it locks execution of gorutine
+// it doesn't close channel after work
+// returns sent elements if len(this) != len(old) considered func was canceled
 func (o OfNumericSlice[T]) Send(ctx context.Context, ch chan<- T) OfNumericSlice[T] {
 	return OfNumericSlice[T]{Send(ctx, o.Result, ch)}
 }
 
+// Sequence generates all numbers in range or returns nil if params invalid
+//
+// There are 3 variations to generate:
+//  1. [0, n).
+//  2. [min, max).
+//  3. [min, max) with step.
+//
+// if len(params) == 1 considered that will be returned slice between 0 and n,
+// where n is the first param, [0, n).
+// if len(params) == 2 considered that will be returned slice between min and max,
+// where min is the first param, max is the second, [min, max).
+// if len(params) > 2 considered that will be returned slice between min and max with step,
+// where min is the first param, max is the second, step is the third one, [min, max) with step,
+// others params will be ignored
 func (o OfNumericSlice[T]) Sequence(params ...int) OfNumericSlice[T] {
 	return OfNumericSlice[T]{Sequence(o.Result, params...)}
 }
 
+// SequenceUsing generates slice in range using creator function
+//
+// There are 3 variations to generate:
+//  1. [0, n).
+//  2. [min, max).
+//  3. [min, max) with step.
+//
+// if len(params) == 1 considered that will be returned slice between 0 and n,
+// where n is the first param, [0, n).
+// if len(params) == 2 considered that will be returned slice between min and max,
+// where min is the first param, max is the second, [min, max).
+// if len(params) > 2 considered that will be returned slice between min and max with step,
+// where min is the first param, max is the second, step is the third one, [min, max) with step,
+// others params will be ignored
 func (o OfNumericSlice[T]) SequenceUsing(creator func(int) T, params ...int) OfNumericSlice[T] {
 	return OfNumericSlice[T]{SequenceUsing(o.Result, creator, params...)}
 }
 
+// Shift will return two values: the shifted value and the rest slice.
+// if the slice is empty then returned shifted value is the zero value of the slice elements and the rest slice is empty slice
+func (o OfNumericSlice[T]) Shift() (T, []T) {
+	return Shift(o.Result)
+}
+
+// Shuffle returns a new shuffled slice by your rand.Source. The original slice
+// is not modified.
 func (o OfNumericSlice[T]) Shuffle(source rand.Source) OfNumericSlice[T] {
 	return OfNumericSlice[T]{Shuffle(o.Result, source)}
 }
 
+// ShuffleSecure returns a new shuffled slice using crypto/rand. It is suitable
+// for shuffles that must not be predictable, such as lottery draws. Each order
+// is equally likely. The original slice is not modified.
+//
+// An error is only returned if crypto/rand fails.
+func (o OfNumericSlice[T]) ShuffleSecure() ([]T, error) {
+	return ShuffleSecure(o.Result)
+}
+
+// ShuffleSeed returns a new shuffled slice that is always the same for the
+// same seed. The original slice is not modified.
+//
+// Unlike Shuffle, the order does not depend on the math/rand implementation.
+// It uses SplitMix64 for the random numbers, Lemire's method to choose each
+// index and a Fisher-Yates shuffle (swapping from the last element down). The
+// order for a seed and slice length will not change between versions of pie
+// or Go, so it is safe to use in tests or to store the seed to reproduce a
+// shuffle later.
+func (o OfNumericSlice[T]) ShuffleSeed(seed uint64) OfNumericSlice[T] {
+	return OfNumericSlice[T]{ShuffleSeed(o.Result, seed)}
+}
+
+// ShuffleWith returns a new shuffled slice by your Uint64Source. The original
+// slice is not modified. Unlike Shuffle, it does not need to create a
+// rand.Rand for each call.
+func (o OfNumericSlice[T]) ShuffleWith(source Uint64Source) OfNumericSlice[T] {
+	return OfNumericSlice[T]{ShuffleWith(o.Result, source)}
+}
+
+// Sort works similar to sort.SliceType(). However, unlike sort.SliceType the
+// slice returned will be reallocated as to not modify the input slice.
+//
+// See Reverse() and AreSorted().
 func (o OfNumericSlice[T]) Sort() OfNumericSlice[T] {
 	return OfNumericSlice[T]{Sort(o.Result)}
 }
 
+// SortStableUsing works similar to sort.SliceStable. However, unlike sort.SliceStable the
+// slice returned will be reallocated as to not modify the input slice.
 func (o OfNumericSlice[T]) SortStableUsing(less func(a, b T) bool) OfNumericSlice[T] {
 	return OfNumericSlice[T]{SortStableUsing(o.Result, less)}
 }
 
+// SortUsing works similar to sort.Slice. However, unlike sort.Slice the
+// slice returned will be reallocated as to not modify the input slice.
 func (o OfNumericSlice[T]) SortUsing(less func(a, b T) bool) OfNumericSlice[T] {
 	return OfNumericSlice[T]{SortUsing(o.Result, less)}
 }
 
+// Sparkline returns a single line that shows the shape of the elements, with
+// one block character per element scaled between the smallest and largest
+// element:
+//
+//	Sparkline([1, 2, 3, 4, 5, 6, 7, 8, 7, 1]) => "▁▂▃▄▅▆▇█▇▁"
+//
+// NaN and infinite values are shown as a space. If all of the elements are the
+// same, they are shown as the smallest block.
+func (o OfNumericSlice[T]) Sparkline() string {
+	return Sparkline(o.Result)
+}
+
+// SpearmanRankCorrelation is the Spearman rank correlation coefficient of xs
+// and ys. It is the PearsonCorrelation of the ranks of the elements, so it
+// measures how close they are to always increasing (1) or decreasing (-1)
+// together, even when the relationship is not linear. Equal elements are given
+// the average of their ranks.
+//
+// ErrUnequalLengths is returned if xs and ys are not the same length. NaN is
+// returned if either slice has no variation (including when there are fewer
+// than two elements).
+func (o OfNumericSlice[T]) SpearmanRankCorrelation(ys []T) (float64, error) {
+	return SpearmanRankCorrelation(o.Result, ys)
+}
+
+// Stddev is the standard deviation
 func (o OfNumericSlice[T]) Stddev() float64 {
 	return Stddev(o.Result)
 }
 
+// Strings transforms each element to a string.
+//
+// If the element type implements fmt.Stringer it will be used. Otherwise it
+// will fallback to the result of:
+//
+//	fmt.Sprintf("%v")
 func (o OfNumericSlice[T]) Strings() []string {
 	return Strings(o.Result)
 }
 
+// StringsUsing transforms each element to a string.
 func (o OfNumericSlice[T]) StringsUsing(transform func(T) string) []string {
 	return StringsUsing(o.Result, transform)
 }
 
+// SubSlice will return the subSlice from start to end(excluded)
+//
+// Condition 1: If start < 0 or end < 0, nil is returned.
+// Condition 2: If start >= end, nil is returned.
+// Condition 3: Return all elements that exist in the range provided,
+// if start or end is out of bounds, zero items will be placed.
 func (o OfNumericSlice[T]) SubSlice(start int, end int) OfNumericSlice[T] {
 	return OfNumericSlice[T]{SubSlice(o.Result, start, end)}
 }
 
+// Subtract returns the elements of ss1 minus the element at the same position of
+// ss2:
+//
+//	Subtract([10, 20, 30], [1, 2, 3]) => [9, 18, 27]
+//
+// ErrUnequalLengths is returned if ss1 and ss2 are not the same length. nil is
+// returned if there are no elements.
+func (o OfNumericSlice[T]) Subtract(ss2 []T) ([]T, error) {
+	return Subtract(o.Result, ss2)
+}
+
+// Sum is the sum of all of the elements.
 func (o OfNumericSlice[T]) Sum() T {
 	return Sum(o.Result)
 }
 
+// Table writes ss to w as a table with a header row, aligning the columns so
+// that they are easy to read in a terminal:
+//
+//	+----+------+
+//	| ID | Name |
+//	+----+------+
+//	|  1 | Bob  |
+//	| 23 | Jane |
+//	+----+------+
+//
+// If no columns are provided and T is a struct (or a pointer to a struct),
+// there will be a column for each exported field. Otherwise, there will be a
+// single column called "Value".
+//
+// Widths are counted in runes, so characters that are displayed wider (or
+// narrower) than others may not line up. New lines in cells are replaced with
+// spaces.
+func (o OfNumericSlice[T]) Table(w io.Writer, style TableStyle, columns ...TableColumn[T]) error {
+	return Table(w, o.Result, style, columns...)
+}
+
+// ToCSV writes ss to w as CSV with a header row, followed by one row for each
+// element. The header is always written, even if ss is empty.
+//
+// If no columns are provided, the columns from CSVFields are used, so T must
+// be a struct or a pointer to a struct. Otherwise, the columns are written in
+// the order they are provided:
+//
+//	pie.ToCSV(w, users,
+//		pie.CSVColumn[User]{Name: "id", Value: func(u User) string { return u.ID }},
+//		pie.CSVColumn[User]{Name: "email", Value: func(u User) string { return u.Email }},
+//	)
+//
+// A *CSVError is returned if a field from CSVFields cannot be formatted.
+func (o OfNumericSlice[T]) ToCSV(w io.Writer, columns ...CSVColumn[T]) error {
+	return ToCSV(w, o.Result, columns...)
+}
+
+// Top will return n elements from head of the slice
+// if the slice has less elements then n that'll return all elements
+// if n < 0 it'll return empty slice.
 func (o OfNumericSlice[T]) Top(n int) OfNumericSlice[T] {
 	return OfNumericSlice[T]{Top(o.Result, n)}
 }
 
+// TrimmedMean is the average of the elements after removing the smallest and
+// largest fraction of them, which makes it less sensitive to outliers than
+// Average. For example, a fraction of 0.1 ignores the lowest 10% and highest
+// 10% of the elements:
+//
+//	TrimmedMean([1, 2, 3, 4, 5, 6, 7, 8, 9, 1000], 0.1) => 5.5
+//
+// The number of elements removed from each end is rounded down. Zero is
+// returned if there are no elements. It will panic if fraction is not in the
+// range [0, 0.5).
+func (o OfNumericSlice[T]) TrimmedMean(fraction float64) float64 {
+	return TrimmedMean(o.Result, fraction)
+}
+
+// Unique returns a new slice with all of the unique values.
+//
+// The items will be returned in a randomized order, even with the same input.
+//
+// The number of items returned may be the same as the input or less. It will
+// never return zero items unless then input slice has zero items.
+//
+// A slice with zero elements is considered to be unique.
+//
+// See AreUnique(). Use Dedup to only remove consecutive duplicates.
 func (o OfNumericSlice[T]) Unique() OfNumericSlice[T] {
 	return OfNumericSlice[T]{Unique(o.Result)}
 }
 
+// UniqueStable works similar to Unique. However, unlike Unique
+// the slice returned will be in previous relative order
 func (o OfNumericSlice[T]) UniqueStable() OfNumericSlice[T] {
 	return OfNumericSlice[T]{UniqueStable(o.Result)}
 }
 
+// Unshift adds one or more elements to the beginning of the slice
+// and returns the new slice.
 func (o OfNumericSlice[T]) Unshift(elements ...T) OfNumericSlice[T] {
 	return OfNumericSlice[T]{Unshift(o.Result, elements...)}
 }
 
-func (o OfNumericSlice[T]) Delete(idx ...int) OfNumericSlice[T] {
-	return OfNumericSlice[T]{Delete(o.Result, idx...)}
+// WeightedAverage is the average of the elements where each element counts
+// weights[i] times, that is sum(ss[i] * weights[i]) / sum(weights):
+//
+//	WeightedAverage([1, 2, 3], [3, 1, 0]) => 1.25
+//
+// ErrUnequalLengths is returned if ss and weights are not the same length.
+// Zero is returned if the weights add up to zero, including when there are no
+// elements.
+func (o OfNumericSlice[T]) WeightedAverage(weights []float64) (float64, error) {
+	return WeightedAverage(o.Result, weights)
+}
+
+// WeightedChoice returns a random element by your rand.Source, where the chance
+// of each element being chosen is proportional to its weight. Elements with a
+// weight of zero or less are never chosen.
+//
+// If there are no elements with a positive weight, the zero value and false
+// are returned.
+func (o OfNumericSlice[T]) WeightedChoice(weight func(T) float64, source rand.Source) (T, bool) {
+	return WeightedChoice(o.Result, weight, source)
+}
+
+// WeightedSample returns k different elements chosen at random by your
+// rand.Source, where the chance of each element being chosen is proportional
+// to its weight. Elements with a weight of zero or less are never chosen, so
+// fewer than k elements are returned if there are not enough elements with a
+// positive weight.
+//
+// Like Sample, each element can only be chosen once and the chosen elements are
+// returned in the same order that they appear in ss. If k is less than 1, nil
+// is returned.
+//
+// WeightedSample uses the algorithm by Efraimidis and Spirakis, which gives
+// each element a random key based on its weight and chooses the k largest
+// keys.
+func (o OfNumericSlice[T]) WeightedSample(k int, weight func(T) float64, source rand.Source) OfNumericSlice[T] {
+	return OfNumericSlice[T]{WeightedSample(o.Result, k, weight, source)}
+}
+
+// WriteJSONLines writes each element of ss to w as a single line of JSON (also
+// known as JSON Lines or NDJSON). Elements are encoded and written one at a
+// time, so the whole output is never held in memory.
+//
+// Nothing is written for an empty slice. If an element cannot be encoded, the
+// elements before it will have already been written and the error includes the
+// index of the element.
+func (o OfNumericSlice[T]) WriteJSONLines(w io.Writer) error {
+	return WriteJSONLines(w, o.Result)
+}
+
+// ZScore returns the number of standard deviations each element is from the
+// mean, using the population standard deviation (like Stddev):
+//
+//	ZScore([2, 4, 4, 4, 5, 5, 7, 9]) => [-1.5, -0.5, -0.5, -0.5, 0, 0, 1, 2]
+//
+// The mean and standard deviation are calculated with float64, so they cannot
+// overflow for small integer types.
+//
+// If all of the elements are the same (so the standard deviation is zero),
+// every value is 0. nil is returned if there are no elements in the slice.
+func (o OfNumericSlice[T]) ZScore() []float64 {
+	return ZScore(o.Result)
+}
+
+// Zip will return a new slice containing pairs with elements from input slices.
+// If input slices have diffrent length, the output slice will be truncated to
+// the length of the smallest input slice.
+func (o OfNumericSlice[T]) Zip(ss2 []T) []Zipped[T, T] {
+	return Zip(o.Result, ss2)
+}
+
+// Zip3 works like Zip for three slices. The output slice will be truncated to
+// the length of the smallest input slice.
+func (o OfNumericSlice[T]) Zip3(ss2 []T, ss3 []T) []Zipped3[T, T, T] {
+	return Zip3(o.Result, ss2, ss3)
+}
+
+// ZipLongest will return a new slice containing pairs with elements from input slices.
+// If input slices have diffrent length, missing elements will be padded with default values.
+func (o OfNumericSlice[T]) ZipLongest(ss2 []T) []Zipped[T, T] {
+	return ZipLongest(o.Result, ss2)
+}
+
+// ZipLongestWith works like ZipLongest, except that missing elements are
+// padded with fill1 (when ss1 is shorter) or fill2 (when ss2 is shorter)
+// instead of the zero value.
+func (o OfNumericSlice[T]) ZipLongestWith(ss2 []T, fill1 T, fill2 T) []Zipped[T, T] {
+	return ZipLongestWith(o.Result, ss2, fill1, fill2)
+}
+
+// ZipStrict works like Zip, except that it returns ErrUnequalLengths (and a nil
+// slice) if ss1 and ss2 are not the same length, rather than truncating.
+func (o OfNumericSlice[T]) ZipStrict(ss2 []T) ([]Zipped[T, T], error) {
+	return ZipStrict(o.Result, ss2)
+}
+
+// ZipWith returns a new slice containing the result of fn for each pair of
+// elements from ss1 and ss2. It is the same as mapping the result of Zip, but
+// without creating the pairs:
+//
+//	ZipWith([1, 2, 3], [10, 20], add) => [11, 22]
+//
+// If the input slices have different lengths, the output slice will be
+// truncated to the length of the smallest input slice.
+func (o OfNumericSlice[T]) ZipWith(ss2 []T, fn func(T, T) T) OfNumericSlice[T] {
+	return OfNumericSlice[T]{ZipWith(o.Result, ss2, fn)}
 }
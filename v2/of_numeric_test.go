@@ -40,4 +40,21 @@ func TestOfONumeric(t *testing.T) {
 
 		assert.Equal(t, []float64{-4.56, 1.23}, names)
 	})
+
+	t.Run("rolling", func(t *testing.T) {
+		means := pie.OfNumeric([]float64{3, 1, 4, 1, 5, 9}).
+			RollingMax(2).
+			RollingMean(2)
+
+		assert.Equal(t, []float64{3.5, 4, 4.5, 7}, means)
+	})
+
+	t.Run("cumulative", func(t *testing.T) {
+		diffs := pie.OfNumeric([]int{1, 2, 3, 4}).
+			CumulativeSum().
+			Diffs().
+			Result
+
+		assert.Equal(t, []int{2, 3, 4}, diffs)
+	})
 }
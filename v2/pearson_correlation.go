@@ -0,0 +1,33 @@
+package pie
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// PearsonCorrelation is the Pearson correlation coefficient of xs and ys. It
+// measures how close they are to having a linear relationship, from -1 (as one
+// increases the other decreases) to 1 (they increase together). Zero means
+// there is no linear relationship.
+//
+// ErrUnequalLengths is returned if xs and ys are not the same length. NaN is
+// returned if either slice has no variation (including when there are fewer
+// than two elements).
+func PearsonCorrelation[T constraints.Integer | constraints.Float](xs, ys []T) (float64, error) {
+	if err := checkLengths(len(xs), len(ys)); err != nil {
+		return 0, err
+	}
+
+	if len(xs) == 0 {
+		return math.NaN(), nil
+	}
+
+	sxy, sxx, syy := sumsOfProducts(xs, ys)
+	if sxx == 0 || syy == 0 {
+		return math.NaN(), nil
+	}
+
+	// Rounding can put the result slightly outside of [-1, 1].
+	return math.Max(-1, math.Min(1, sxy/math.Sqrt(sxx*syy))), nil
+}
@@ -0,0 +1,41 @@
+package pie_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var pearsonCorrelationTests = []struct {
+	xs, ys   []int
+	expected float64
+}{
+	{nil, nil, math.NaN()},
+	{[]int{1}, []int{1}, math.NaN()},
+	{[]int{1, 2, 3}, []int{5, 5, 5}, math.NaN()},
+	{[]int{1, 2, 3}, []int{10, 20, 30}, 1},
+	{[]int{1, 2, 3}, []int{3, 2, 1}, -1},
+	{[]int{1, 2, 3, 4}, []int{1, 3, 2, 4}, 0.8},
+	{[]int{1, 2, 3, 4, 5}, []int{1, 4, 9, 16, 25}, 0.9811049102515929},
+}
+
+func TestPearsonCorrelation(t *testing.T) {
+	for _, test := range pearsonCorrelationTests {
+		t.Run("", func(t *testing.T) {
+			correlation, err := pie.PearsonCorrelation(test.xs, test.ys)
+			assert.NoError(t, err)
+			if math.IsNaN(test.expected) {
+				assert.True(t, math.IsNaN(correlation))
+			} else {
+				assert.InDelta(t, test.expected, correlation, 1e-12)
+			}
+		})
+	}
+}
+
+func TestPearsonCorrelationUnequalLengths(t *testing.T) {
+	_, err := pie.PearsonCorrelation([]int{1, 2}, []int{1, 2, 3})
+	assert.EqualError(t, err, "slices have different lengths: 2 and 3")
+}
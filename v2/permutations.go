@@ -0,0 +1,15 @@
+package pie
+
+// Permutations returns every ordering of the elements in ss. See
+// PermutationsSeq for the order.
+//
+// There are n! permutations, so ErrTooManyResults is returned (without
+// generating any permutations) if there would be more than max.
+func Permutations[T any](ss []T, max int) ([][]T, error) {
+	count, ok := factorial(len(ss))
+	if err := checkResults(count, ok, max); err != nil {
+		return nil, err
+	}
+
+	return collect(PermutationsSeq(ss), count), nil
+}
@@ -0,0 +1,54 @@
+package pie
+
+import "golang.org/x/exp/slices"
+
+// PermutationsSeq returns a sequence (that can be used as an iter.Seq) of every
+// ordering of the elements in ss. Elements are treated as different based on
+// their position, not their value, so equal elements produce repeated
+// permutations.
+//
+// The permutations are produced in lexicographic order of the positions:
+//
+//	PermutationsSeq([a, b, c]) => [a, b, c], [a, c, b], [b, a, c], [b, c, a], ...
+//
+// There are n! permutations, including a single empty permutation if ss is
+// empty. They are generated lazily and each one is a new slice.
+func PermutationsSeq[T any](ss []T) func(yield func([]T) bool) {
+	ss = slices.Clone(ss)
+
+	return func(yield func([]T) bool) {
+		indexes := make([]int, len(ss))
+		for i := range indexes {
+			indexes[i] = i
+		}
+
+		for yield(pick(ss, indexes)) && nextPermutation(indexes) {
+		}
+	}
+}
+
+// nextPermutation rearranges indexes into the next permutation in
+// lexicographic order. It returns false if indexes is the last permutation.
+func nextPermutation(indexes []int) bool {
+	i := len(indexes) - 2
+	for i >= 0 && indexes[i] >= indexes[i+1] {
+		i--
+	}
+
+	if i < 0 {
+		return false
+	}
+
+	j := len(indexes) - 1
+	for indexes[j] <= indexes[i] {
+		j--
+	}
+
+	indexes[i], indexes[j] = indexes[j], indexes[i]
+
+	for a, b := i+1, len(indexes)-1; a < b; a, b = a+1, b-1 {
+		indexes[a], indexes[b] = indexes[b], indexes[a]
+	}
+
+	return true
+}
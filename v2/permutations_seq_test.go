@@ -0,0 +1,35 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// take returns up to n values from seq.
+func take[T any](seq func(yield func(T) bool), n int) (values []T) {
+	seq(func(value T) bool {
+		values = append(values, value)
+		return len(values) < n
+	})
+
+	return
+}
+
+func TestPermutationsSeq(t *testing.T) {
+	// 20! permutations would never finish if they were not lazy.
+	ss := pie.Sequence([]int{}, 20)
+	assert.Equal(t, [][]int{
+		pie.Sequence([]int{}, 20),
+		append(pie.Sequence([]int{}, 18), 19, 18),
+	}, take(pie.PermutationsSeq(ss), 2))
+}
+
+func TestPermutationsSeqCopiesInput(t *testing.T) {
+	ss := []int{1, 2}
+	seq := pie.PermutationsSeq(ss)
+	ss[0] = 9
+
+	assert.Equal(t, [][]int{{1, 2}, {2, 1}}, take(seq, 10))
+}
@@ -0,0 +1,49 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var permutationsTests = []struct {
+	ss       []string
+	expected [][]string
+}{
+	{nil, [][]string{{}}},
+	{[]string{"a"}, [][]string{{"a"}}},
+	{[]string{"a", "b"}, [][]string{{"a", "b"}, {"b", "a"}}},
+	{
+		[]string{"a", "b", "c"},
+		[][]string{
+			{"a", "b", "c"}, {"a", "c", "b"}, {"b", "a", "c"},
+			{"b", "c", "a"}, {"c", "a", "b"}, {"c", "b", "a"},
+		},
+	},
+	{[]string{"a", "a"}, [][]string{{"a", "a"}, {"a", "a"}}},
+}
+
+func TestPermutations(t *testing.T) {
+	for _, test := range permutationsTests {
+		t.Run("", func(t *testing.T) {
+			permutations, err := pie.Permutations(test.ss, 100)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, permutations)
+		})
+	}
+}
+
+func TestPermutationsTooMany(t *testing.T) {
+	permutations, err := pie.Permutations([]int{1, 2, 3, 4}, 23)
+	assert.ErrorIs(t, err, pie.ErrTooManyResults)
+	assert.EqualError(t, err, "too many results: 24 is more than 23")
+	assert.Nil(t, permutations)
+
+	_, err = pie.Permutations(pie.Sequence([]int{}, 21), 1000)
+	assert.EqualError(t, err, "too many results: more than 1000")
+
+	permutations, err = pie.Permutations([]int{1, 2, 3, 4}, 24)
+	assert.NoError(t, err)
+	assert.Len(t, permutations, 24)
+}
@@ -0,0 +1,14 @@
+// The pielint command runs the pielint analyzer. It can be used directly, or
+// with go vet:
+//
+//	go vet -vettool=$(which pielint) ./...
+package main
+
+import (
+	"github.com/elliotchance/pie/v2/pielint"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(pielint.Analyzer)
+}
@@ -0,0 +1,510 @@
+// Package pielint defines an Analyzer that reports common mistakes when using
+// github.com/elliotchance/pie/v2.
+//
+// It can be run with go vet:
+//
+//	go install github.com/elliotchance/pie/v2/pielint/cmd/pielint@latest
+//	go vet -vettool=$(which pielint) ./...
+package pielint
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const piePath = "github.com/elliotchance/pie/v2"
+
+const doc = `report common mistakes when using pie
+
+The pielint analyzer reports:
+
+- Calls to pie functions where the result is not used, such as pie.Sort(ss)
+  instead of ss = pie.Sort(ss). The functions do not modify their input.
+
+- Constant sizes that are not greater than zero, such as pie.Chunk(ss, 0).
+  These will always panic.
+
+- Results of pie.Keys, pie.Values, pie.Mode, pie.Unique and pie.Intersect that
+  are compared or indexed without being sorted first. The order of these
+  results depends on map iteration, so it will change between runs.`
+
+// Analyzer reports common mistakes when using pie.
+var Analyzer = &analysis.Analyzer{
+	Name:     "pielint",
+	Doc:      doc,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// sideEffects are the functions and methods that are still useful when their
+// result is not used.
+var sideEffects = map[string]bool{
+	"Each":    true,
+	"Pop":     true,
+	"Run":     true,
+	"RunChan": true,
+	"RunSeq":  true,
+	"Send":    true,
+}
+
+// sizeParams are the parameters that will cause a panic if they are not
+// greater than zero.
+var sizeParams = map[string]string{
+	"AppendChunk":        "chunkLength",
+	"Chunk":              "chunkLength",
+	"Histogram":          "bins",
+	"HistogramBars":      "width",
+	"HistogramQuantiles": "bins",
+	"RollingMax":         "window",
+	"RollingMean":        "window",
+	"RollingMedian":      "window",
+	"RollingMin":         "window",
+	"RollingSum":         "window",
+}
+
+// unorderedFuncs return elements in the order of a map iteration.
+var unorderedFuncs = map[string]bool{
+	"Intersect": true,
+	"Keys":      true,
+	"Mode":      true,
+	"Unique":    true,
+	"Values":    true,
+}
+
+// orderSensitiveFuncs give a different result depending on the order of the
+// elements. These are matched by name in any package, which covers testify,
+// reflect.DeepEqual and go-cmp.
+var orderSensitiveFuncs = map[string]bool{
+	"DeepEqual":    true,
+	"Diff":         true,
+	"Equal":        true,
+	"EqualValues":  true,
+	"EqualValuesf": true,
+	"Equalf":       true,
+	"Exactly":      true,
+	"Exactlyf":     true,
+	"Join":         true,
+	"NotEqual":     true,
+	"NotEqualf":    true,
+}
+
+// orderSensitivePieFuncs are the same as orderSensitiveFuncs, but only for
+// functions in the pie package.
+var orderSensitivePieFuncs = map[string]bool{
+	"Bottom":  true,
+	"Equals":  true,
+	"First":   true,
+	"FirstOr": true,
+	"Last":    true,
+	"LastOr":  true,
+	"Top":     true,
+}
+
+// sortedResultFuncs are the pie functions that return a sorted copy of their
+// input.
+var sortedResultFuncs = map[string]bool{
+	"Sort":            true,
+	"SortStableUsing": true,
+	"SortUsing":       true,
+}
+
+// inPlaceSortPkgs are the packages with functions that sort a slice in place.
+// Only the functions starting with "Sort" are considered, except for the sort
+// package where every function is.
+var inPlaceSortPkgs = map[string]bool{
+	"golang.org/x/exp/slices":                true,
+	"slices":                                 true,
+	"sort":                                   true,
+	"github.com/elliotchance/pie/v2/inplace": true,
+}
+
+type checker struct {
+	pass *analysis.Pass
+
+	// unordered contains the variables that were assigned the result of one of
+	// the unorderedFuncs. The value is the name of the function.
+	unordered map[types.Object]string
+
+	// sorted contains variables that are assigned the result of one of the
+	// sortedResultFuncs, or are sorted in place. It does not matter where the
+	// sort happens.
+	sorted map[types.Object]bool
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	c := &checker{
+		pass:      pass,
+		unordered: map[types.Object]string{},
+		sorted:    map[types.Object]bool{},
+	}
+
+	// All of the variables must be known before any of their uses can be
+	// checked.
+	inspect.Preorder([]ast.Node{
+		(*ast.AssignStmt)(nil),
+		(*ast.ValueSpec)(nil),
+		(*ast.CallExpr)(nil),
+	}, c.collect)
+
+	inspect.WithStack([]ast.Node{
+		(*ast.ExprStmt)(nil),
+		(*ast.CallExpr)(nil),
+		(*ast.IndexExpr)(nil),
+	}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		file := stack[0].(*ast.File)
+		switch n := n.(type) {
+		case *ast.ExprStmt:
+			c.checkDiscarded(n)
+
+		case *ast.CallExpr:
+			c.checkSize(n)
+			c.checkOrderSensitiveCall(file, n)
+
+		case *ast.IndexExpr:
+			c.checkOrderSensitiveIndex(file, n)
+		}
+
+		return true
+	})
+
+	return nil, nil
+}
+
+// pieFunc returns the pie function or method that is called, or nil if the
+// call is for anything else.
+func (c *checker) pieFunc(call *ast.CallExpr) *types.Func {
+	fn, ok := typeutil.Callee(c.pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != piePath {
+		return nil
+	}
+
+	return fn
+}
+
+// displayName is used in messages, such as "pie.Sort" or
+// "pie.OfSlice.Sort".
+func displayName(fn *types.Func) string {
+	recv := fn.Type().(*types.Signature).Recv()
+	if recv == nil {
+		return "pie." + fn.Name()
+	}
+
+	t := recv.Type()
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	if named, ok := t.(*types.Named); ok {
+		return "pie." + named.Obj().Name() + "." + fn.Name()
+	}
+
+	return fn.Name()
+}
+
+func (c *checker) collect(n ast.Node) {
+	switch n := n.(type) {
+	case *ast.AssignStmt:
+		if len(n.Lhs) == len(n.Rhs) {
+			for i := range n.Rhs {
+				c.collectUnordered(n.Lhs[i], n.Rhs[i])
+				c.collectSortedResult(n.Lhs[i], n.Rhs[i])
+			}
+		}
+
+	case *ast.ValueSpec:
+		if len(n.Names) == len(n.Values) {
+			for i := range n.Values {
+				c.collectUnordered(n.Names[i], n.Values[i])
+				c.collectSortedResult(n.Names[i], n.Values[i])
+			}
+		}
+
+	case *ast.CallExpr:
+		fn, ok := typeutil.Callee(c.pass.TypesInfo, n).(*types.Func)
+		if !ok || fn.Pkg() == nil || !inPlaceSortPkgs[fn.Pkg().Path()] {
+			return
+		}
+
+		// Everything in the sort package (such as sort.Strings) sorts.
+		if fn.Pkg().Path() != "sort" && !strings.HasPrefix(fn.Name(), "Sort") {
+			return
+		}
+
+		for _, arg := range n.Args {
+			// Such as sort.Sort(sort.StringSlice(ss)).
+			if conversion, ok := astutil.Unparen(arg).(*ast.CallExpr); ok &&
+				len(conversion.Args) == 1 && c.pass.TypesInfo.Types[conversion.Fun].IsType() {
+				arg = conversion.Args[0]
+			}
+
+			c.markSorted(arg)
+		}
+	}
+}
+
+// collectSortedResult marks lhs as sorted if it is assigned the result of one
+// of the sortedResultFuncs.
+func (c *checker) collectSortedResult(lhs, rhs ast.Expr) {
+	call, ok := astutil.Unparen(rhs).(*ast.CallExpr)
+	if !ok {
+		return
+	}
+
+	fn := c.pieFunc(call)
+	if fn == nil || fn.Type().(*types.Signature).Recv() != nil ||
+		!sortedResultFuncs[fn.Name()] {
+		return
+	}
+
+	c.markSorted(lhs)
+}
+
+func (c *checker) markSorted(expr ast.Expr) {
+	if id, ok := astutil.Unparen(expr).(*ast.Ident); ok {
+		if obj := c.pass.TypesInfo.ObjectOf(id); obj != nil {
+			c.sorted[obj] = true
+		}
+	}
+}
+
+func (c *checker) collectUnordered(lhs, rhs ast.Expr) {
+	id, ok := lhs.(*ast.Ident)
+	if !ok {
+		return
+	}
+
+	call, ok := astutil.Unparen(rhs).(*ast.CallExpr)
+	if !ok {
+		return
+	}
+
+	if name := c.unorderedCall(call); name != "" {
+		if obj := c.pass.TypesInfo.ObjectOf(id); obj != nil {
+			c.unordered[obj] = name
+		}
+	}
+}
+
+func (c *checker) unorderedCall(call *ast.CallExpr) string {
+	fn := c.pieFunc(call)
+	if fn == nil || fn.Type().(*types.Signature).Recv() != nil ||
+		!unorderedFuncs[fn.Name()] {
+		return ""
+	}
+
+	return displayName(fn)
+}
+
+// unorderedExpr returns the name of the function that produced expr if the
+// order of its elements is random. Otherwise an empty string is returned.
+func (c *checker) unorderedExpr(expr ast.Expr) string {
+	switch expr := astutil.Unparen(expr).(type) {
+	case *ast.CallExpr:
+		return c.unorderedCall(expr)
+
+	case *ast.Ident:
+		obj := c.pass.TypesInfo.Uses[expr]
+		if name, ok := c.unordered[obj]; ok && !c.sorted[obj] {
+			return name
+		}
+	}
+
+	return ""
+}
+
+func (c *checker) checkDiscarded(stmt *ast.ExprStmt) {
+	call, ok := astutil.Unparen(stmt.X).(*ast.CallExpr)
+	if !ok {
+		return
+	}
+
+	fn := c.pieFunc(call)
+	if fn == nil || sideEffects[fn.Name()] ||
+		fn.Type().(*types.Signature).Results().Len() == 0 {
+		return
+	}
+
+	diagnostic := analysis.Diagnostic{
+		Pos:     call.Pos(),
+		End:     call.End(),
+		Message: fmt.Sprintf("result of %s is not used", displayName(fn)),
+	}
+
+	if target := c.assignTarget(fn, call); target != nil {
+		s := types.ExprString(target)
+		diagnostic.SuggestedFixes = []analysis.SuggestedFix{{
+			Message: fmt.Sprintf("Assign the result to %s", s),
+			TextEdits: []analysis.TextEdit{{
+				Pos:     stmt.Pos(),
+				End:     stmt.Pos(),
+				NewText: []byte(s + " = "),
+			}},
+		}}
+	}
+
+	c.pass.Report(diagnostic)
+}
+
+// assignTarget returns the variable that the result of call can be assigned
+// back to. That is the first argument for functions, or the receiver for
+// methods. It returns nil if there is no variable or it has a different type.
+func (c *checker) assignTarget(fn *types.Func, call *ast.CallExpr) ast.Expr {
+	var target ast.Expr
+	if fn.Type().(*types.Signature).Recv() != nil {
+		sel, ok := astutil.Unparen(call.Fun).(*ast.SelectorExpr)
+		if !ok {
+			return nil
+		}
+		target = sel.X
+	} else if len(call.Args) > 0 {
+		target = call.Args[0]
+	}
+
+	var id *ast.Ident
+	switch t := target.(type) {
+	case *ast.Ident:
+		id = t
+	case *ast.SelectorExpr:
+		id = t.Sel
+	default:
+		return nil
+	}
+
+	if _, ok := c.pass.TypesInfo.Uses[id].(*types.Var); !ok {
+		return nil
+	}
+
+	result := c.pass.TypesInfo.TypeOf(call)
+	if result == nil || !types.AssignableTo(result, c.pass.TypesInfo.TypeOf(target)) {
+		return nil
+	}
+
+	return target
+}
+
+func (c *checker) checkSize(call *ast.CallExpr) {
+	fn := c.pieFunc(call)
+	if fn == nil {
+		return
+	}
+
+	param, ok := sizeParams[fn.Name()]
+	if !ok {
+		return
+	}
+
+	params := fn.Type().(*types.Signature).Params()
+	for i := 0; i < params.Len() && i < len(call.Args); i++ {
+		if params.At(i).Name() != param {
+			continue
+		}
+
+		value := c.pass.TypesInfo.Types[call.Args[i]].Value
+		if value != nil && value.Kind() == constant.Int && constant.Sign(value) <= 0 {
+			c.pass.Reportf(call.Args[i].Pos(),
+				"%s will panic because %s is %s, it must be greater than 0",
+				displayName(fn), param, value)
+		}
+	}
+}
+
+func (c *checker) checkOrderSensitiveCall(file *ast.File, call *ast.CallExpr) {
+	fn, ok := typeutil.Callee(c.pass.TypesInfo, call).(*types.Func)
+	if !ok {
+		return
+	}
+
+	isPie := fn.Pkg() != nil && fn.Pkg().Path() == piePath
+	if !orderSensitiveFuncs[fn.Name()] &&
+		!(isPie && orderSensitivePieFuncs[fn.Name()]) {
+		return
+	}
+
+	for _, arg := range call.Args {
+		if name := c.unorderedExpr(arg); name != "" {
+			c.reportUnordered(file, arg, name, "before calling "+fn.Name())
+		}
+	}
+}
+
+func (c *checker) checkOrderSensitiveIndex(file *ast.File, index *ast.IndexExpr) {
+	if _, ok := c.pass.TypesInfo.TypeOf(index.X).Underlying().(*types.Slice); !ok {
+		return
+	}
+
+	// Indexing with a variable is usually part of a loop over all of the
+	// elements.
+	if c.pass.TypesInfo.Types[index.Index].Value == nil {
+		return
+	}
+
+	if name := c.unorderedExpr(index.X); name != "" {
+		c.reportUnordered(file, index.X, name, "before indexing")
+	}
+}
+
+func (c *checker) reportUnordered(file *ast.File, expr ast.Expr, name, when string) {
+	diagnostic := analysis.Diagnostic{
+		Pos: expr.Pos(),
+		End: expr.End(),
+		Message: fmt.Sprintf("%s returns elements in a random order, sort them %s",
+			name, when),
+	}
+
+	// pie.Sort only works with ordered elements.
+	var basic *types.Basic
+	if slice, ok := c.pass.TypesInfo.TypeOf(expr).Underlying().(*types.Slice); ok {
+		basic, _ = slice.Elem().Underlying().(*types.Basic)
+	}
+
+	pkg := pieName(file)
+	if pkg != "" && basic != nil && basic.Info()&types.IsOrdered != 0 {
+		diagnostic.SuggestedFixes = []analysis.SuggestedFix{{
+			Message: "Sort with " + pkg + ".Sort",
+			TextEdits: []analysis.TextEdit{
+				{Pos: expr.Pos(), End: expr.Pos(), NewText: []byte(pkg + ".Sort(")},
+				{Pos: expr.End(), End: expr.End(), NewText: []byte(")")},
+			},
+		}}
+	}
+
+	c.pass.Report(diagnostic)
+}
+
+// pieName returns the name that the pie package is imported as, or an empty
+// string if it cannot be referenced from file.
+func pieName(file *ast.File) string {
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || path != piePath {
+			continue
+		}
+
+		if spec.Name == nil {
+			return "pie"
+		}
+
+		if spec.Name.Name == "_" || spec.Name.Name == "." {
+			return ""
+		}
+
+		return spec.Name.Name
+	}
+
+	return ""
+}
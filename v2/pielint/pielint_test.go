@@ -0,0 +1,12 @@
+package pielint_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2/pielint"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), pielint.Analyzer, "a")
+}
@@ -0,0 +1,83 @@
+package a
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type point struct {
+	X, Y int
+}
+
+type order struct {
+	Items []string
+}
+
+func discarded(ss []int, o *order) {
+	pie.Sort(ss)                // want `result of pie.Sort is not used`
+	pie.Insert(o.Items, 0, "a") // want `result of pie.Insert is not used`
+	pie.Reverse(pie.Sort(ss))   // want `result of pie.Reverse is not used`
+	pie.Of(ss).Reverse()        // want `result of pie.OfSlice.Reverse is not used`
+	of := pie.Of(ss)
+	of.Reverse() // want `result of pie.OfSlice.Reverse is not used`
+
+	pie.Each(ss, func(int) {})
+	ss = pie.Sort(ss)
+	_ = pie.Reverse(ss)
+}
+
+func sizes(ss []int) {
+	const none = 0
+
+	_ = pie.Chunk(ss, 0)                           // want `pie.Chunk will panic because chunkLength is 0, it must be greater than 0`
+	_ = pie.Chunk(ss, none)                        // want `pie.Chunk will panic because chunkLength is 0, it must be greater than 0`
+	_ = pie.RollingSum(ss, -1)                     // want `pie.RollingSum will panic because window is -1, it must be greater than 0`
+	_ = pie.Of(ss).Chunk(0)                        // want `pie.OfSlice.Chunk will panic because chunkLength is 0, it must be greater than 0`
+	_ = pie.AppendChunk(nil, ss, 0)                // want `pie.AppendChunk will panic because chunkLength is 0, it must be greater than 0`
+	_ = pie.Histogram(ss, 0)                       // want `pie.Histogram will panic because bins is 0, it must be greater than 0`
+	_ = pie.HistogramQuantiles(ss, -2)             // want `pie.HistogramQuantiles will panic because bins is -2, it must be greater than 0`
+	_ = pie.HistogramBars(pie.Histogram(ss, 2), 0) // want `pie.HistogramBars will panic because width is 0, it must be greater than 0`
+	_ = pie.Chunk(ss, 2)
+	_ = pie.Chunk(ss, len(ss))
+}
+
+func unordered(t interface{}, m map[string]int, points map[point]bool) {
+	assert.Equal(t, []string{"a", "b"}, pie.Keys(m)) // want `pie.Keys returns elements in a random order, sort them before calling Equal`
+	_ = pie.Values(m)[0]                             // want `pie.Values returns elements in a random order, sort them before indexing`
+	_ = pie.First(pie.Mode([]int{1, 2}))             // want `pie.Mode returns elements in a random order, sort them before calling First`
+
+	keys := pie.Keys(points)
+	assert.Equal(t, []point{{1, 2}}, keys) // want `pie.Keys returns elements in a random order, sort them before calling Equal`
+
+	values := pie.Values(m)
+	sort.Ints(values)
+	assert.Equal(t, []int{1, 2}, values)
+
+	assert.Equal(t, []string{"a", "b"}, pie.Sort(pie.Keys(m)))
+	assert.ElementsMatch(t, []string{"a", "b"}, pie.Keys(m))
+
+	// pie.Sort returns a sorted copy, so copied is still unordered.
+	copied := pie.Values(m)
+	_ = pie.Sort(copied)
+	assert.Equal(t, []int{1, 2}, copied) // want `pie.Values returns elements in a random order, sort them before calling Equal`
+
+	reassigned := pie.Keys(m)
+	reassigned = pie.Sort(reassigned)
+	assert.Equal(t, []string{"a", "b"}, reassigned)
+
+	inPlace := pie.Keys(m)
+	slices.Sort(inPlace)
+	assert.Equal(t, []string{"a", "b"}, inPlace)
+
+	converted := pie.Keys(m)
+	sort.Sort(sort.StringSlice(converted))
+	assert.Equal(t, []string{"a", "b"}, converted)
+
+	names := pie.Keys(m)
+	for i := range names {
+		_ = names[i]
+	}
+}
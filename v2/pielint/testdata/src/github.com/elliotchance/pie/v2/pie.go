@@ -0,0 +1,42 @@
+// Package pie is a stub of the functions used by the tests.
+package pie
+
+func AppendChunk[T any](dst [][]T, ss []T, chunkLength int) [][]T { return dst }
+
+func Chunk[T any](ss []T, chunkLength int) [][]T { return nil }
+
+func Each[T any](ss []T, fn func(T)) []T { return ss }
+
+func First[T any](ss []T) (t T) { return }
+
+type HistogramBin struct{}
+
+func Histogram[T int | float64](ss []T, bins int) []HistogramBin { return nil }
+
+func HistogramBars(bins []HistogramBin, width int) string { return "" }
+
+func HistogramQuantiles[T int | float64](ss []T, bins int) []HistogramBin { return nil }
+
+func Insert[T any](ss []T, index int, values ...T) []T { return ss }
+
+func Keys[K comparable, V any](m map[K]V) []K { return nil }
+
+func Mode[T comparable](ss []T) []T { return nil }
+
+func Reverse[T any](ss []T) []T { return ss }
+
+func RollingSum[T int | float64](ss []T, window int) []T { return ss }
+
+func Sort[T int | float64 | string](ss []T) []T { return ss }
+
+func Values[K comparable, V any](m map[K]V) []V { return nil }
+
+type OfSlice[T any] struct {
+	Result []T
+}
+
+func Of[T any](ss []T) OfSlice[T] { return OfSlice[T]{ss} }
+
+func (o OfSlice[T]) Chunk(chunkLength int) [][]T { return nil }
+
+func (o OfSlice[T]) Reverse() OfSlice[T] { return o }
@@ -0,0 +1,111 @@
+package pie
+
+import (
+	"time"
+
+	"golang.org/x/exp/slices"
+)
+
+// Pipeline is a reusable sequence of named stages that transforms a slice of T
+// into a slice of U.
+//
+// Go methods cannot introduce type parameters, so chains built with Of,
+// OfOrdered or OfNumeric must keep the same element type. A Pipeline is built
+// with the free function Then instead, so each stage may change the element
+// type:
+//
+//	activeIDs := pie.Then(
+//	    pie.Then(
+//	        pie.NewPipeline[User]("active-ids"),
+//	        pie.FilterStage("active", User.IsActive),
+//	    ),
+//	    pie.MapStage("id", User.ID),
+//	)
+//
+//	ids := activeIDs.Run(users)
+//
+// A Pipeline is immutable. Then and WithHook return a new Pipeline, so a
+// pipeline can be shared and extended in different directions safely.
+type Pipeline[T, U any] struct {
+	name   string
+	stages []string
+	hook   func(StageMetrics)
+	run    func(ss []T, hook func(StageMetrics)) []U
+}
+
+// StageMetrics is passed to the hook of a Pipeline after each stage has run.
+type StageMetrics struct {
+	// Pipeline is the name of the pipeline.
+	Pipeline string
+
+	// Stage is the name of the stage, and Index is its zero-based position in
+	// the pipeline.
+	Stage string
+	Index int
+
+	// In and Out are the number of elements received and returned by the
+	// stage.
+	In, Out int
+
+	// Duration is how long the stage took to run.
+	Duration time.Duration
+}
+
+// NewPipeline creates an empty Pipeline that returns a copy of its input. Use
+// Then to add stages.
+func NewPipeline[T any](name string) Pipeline[T, T] {
+	return Pipeline[T, T]{
+		name: name,
+		run: func(ss []T, _ func(StageMetrics)) []T {
+			return slices.Clone(ss)
+		},
+	}
+}
+
+// Name returns the name the pipeline was created with.
+func (p Pipeline[T, U]) Name() string {
+	return p.name
+}
+
+// Stages returns the names of each of the stages, in the order they run.
+func (p Pipeline[T, U]) Stages() []string {
+	return append([]string(nil), p.stages...)
+}
+
+// WithHook returns a copy of the pipeline that calls hook after each stage
+// has run. This can be used to record timing or other metrics. A nil hook
+// disables any existing hook.
+func (p Pipeline[T, U]) WithHook(hook func(StageMetrics)) Pipeline[T, U] {
+	p.hook = hook
+
+	return p
+}
+
+// Run passes ss through each of the stages and returns the result of the last
+// stage.
+func (p Pipeline[T, U]) Run(ss []T) []U {
+	return p.run(ss, p.hook)
+}
+
+// RunSeq collects all of the values from seq and then runs the pipeline over
+// them. seq is compatible with iter.Seq from Go 1.23.
+func (p Pipeline[T, U]) RunSeq(seq func(yield func(T) bool)) []U {
+	var ss []T
+	seq(func(value T) bool {
+		ss = append(ss, value)
+		return true
+	})
+
+	return p.Run(ss)
+}
+
+// RunChan receives values from ch until it is closed and then runs the
+// pipeline over them.
+func (p Pipeline[T, U]) RunChan(ch <-chan T) []U {
+	var ss []T
+	for value := range ch {
+		ss = append(ss, value)
+	}
+
+	return p.Run(ss)
+}
@@ -0,0 +1,84 @@
+package pie_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var evenStrings = pie.Then(
+	pie.Then(
+		pie.NewPipeline[int]("even-strings"),
+		pie.FilterStage("even", func(i int) bool {
+			return i%2 == 0
+		}),
+	),
+	pie.MapStage("itoa", strconv.Itoa),
+)
+
+func TestNewPipeline(t *testing.T) {
+	p := pie.NewPipeline[int]("empty")
+
+	assert.Equal(t, "empty", p.Name())
+	assert.Empty(t, p.Stages())
+	assert.Equal(t, []int{1, 2}, p.Run([]int{1, 2}))
+}
+
+func TestPipeline_Run(t *testing.T) {
+	assert.Equal(t, "even-strings", evenStrings.Name())
+	assert.Equal(t, []string{"even", "itoa"}, evenStrings.Stages())
+	assert.Nil(t, evenStrings.Run(nil))
+	assert.Equal(t, []string{"2", "4"}, evenStrings.Run([]int{1, 2, 3, 4, 5}))
+}
+
+func TestPipeline_RunSeq(t *testing.T) {
+	seq := func(yield func(int) bool) {
+		for i := 1; i <= 5; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	assert.Equal(t, []string{"2", "4"}, evenStrings.RunSeq(seq))
+}
+
+func TestPipeline_RunChan(t *testing.T) {
+	ch := make(chan int, 5)
+	for i := 1; i <= 5; i++ {
+		ch <- i
+	}
+	close(ch)
+
+	assert.Equal(t, []string{"2", "4"}, evenStrings.RunChan(ch))
+}
+
+func TestPipeline_WithHook(t *testing.T) {
+	var metrics []pie.StageMetrics
+	p := evenStrings.WithHook(func(m pie.StageMetrics) {
+		metrics = append(metrics, m)
+	})
+
+	assert.Equal(t, []string{"2", "4"}, p.Run([]int{1, 2, 3, 4, 5}))
+	assert.Len(t, metrics, 2)
+
+	for i, m := range metrics {
+		assert.Equal(t, "even-strings", m.Pipeline)
+		assert.Equal(t, i, m.Index)
+		assert.GreaterOrEqual(t, int64(m.Duration), int64(0))
+	}
+
+	assert.Equal(t, "even", metrics[0].Stage)
+	assert.Equal(t, 5, metrics[0].In)
+	assert.Equal(t, 2, metrics[0].Out)
+	assert.Equal(t, "itoa", metrics[1].Stage)
+	assert.Equal(t, 2, metrics[1].In)
+	assert.Equal(t, 2, metrics[1].Out)
+
+	// The original pipeline does not have the hook.
+	metrics = nil
+	evenStrings.Run([]int{1, 2})
+	assert.Nil(t, metrics)
+}
@@ -0,0 +1,14 @@
+package pie
+
+// PowerSet returns every subset of ss. See PowerSetSeq for the order.
+//
+// There are 2^n subsets, so ErrTooManyResults is returned (without generating
+// any subsets) if there would be more than max.
+func PowerSet[T any](ss []T, max int) ([][]T, error) {
+	count, ok := uint64(1)<<len(ss), len(ss) < 64
+	if err := checkResults(count, ok, max); err != nil {
+		return nil, err
+	}
+
+	return collect(PowerSetSeq(ss), count), nil
+}
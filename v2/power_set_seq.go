@@ -0,0 +1,24 @@
+package pie
+
+import "golang.org/x/exp/slices"
+
+// PowerSetSeq returns a sequence (that can be used as an iter.Seq) of every
+// subset of ss, starting with the empty subset. The subsets are produced in
+// order of their size, and then in the same order as CombinationsSeq:
+//
+//	PowerSetSeq([a, b, c]) => [], [a], [b], [c], [a, b], [a, c], [b, c], [a, b, c]
+//
+// There are 2^n subsets. They are generated lazily and each one is a new slice.
+func PowerSetSeq[T any](ss []T) func(yield func([]T) bool) {
+	ss = slices.Clone(ss)
+
+	return func(yield func([]T) bool) {
+		stopped := false
+		for k := 0; k <= len(ss) && !stopped; k++ {
+			CombinationsSeq(ss, k)(func(subset []T) bool {
+				stopped = !yield(subset)
+				return !stopped
+			})
+		}
+	}
+}
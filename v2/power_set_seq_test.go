@@ -0,0 +1,13 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPowerSetSeq(t *testing.T) {
+	ss := pie.Sequence([]int{}, 100)
+	assert.Equal(t, [][]int{{}, {0}, {1}}, take(pie.PowerSetSeq(ss), 3))
+}
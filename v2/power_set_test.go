@@ -0,0 +1,41 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var powerSetTests = []struct {
+	ss       []string
+	expected [][]string
+}{
+	{nil, [][]string{{}}},
+	{[]string{"a"}, [][]string{{}, {"a"}}},
+	{
+		[]string{"a", "b", "c"},
+		[][]string{
+			{}, {"a"}, {"b"}, {"c"},
+			{"a", "b"}, {"a", "c"}, {"b", "c"}, {"a", "b", "c"},
+		},
+	},
+}
+
+func TestPowerSet(t *testing.T) {
+	for _, test := range powerSetTests {
+		t.Run("", func(t *testing.T) {
+			subsets, err := pie.PowerSet(test.ss, 100)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, subsets)
+		})
+	}
+}
+
+func TestPowerSetTooMany(t *testing.T) {
+	_, err := pie.PowerSet([]int{1, 2, 3}, 7)
+	assert.EqualError(t, err, "too many results: 8 is more than 7")
+
+	_, err = pie.PowerSet(make([]int, 64), 1000)
+	assert.EqualError(t, err, "too many results: more than 1000")
+}
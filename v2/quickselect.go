@@ -0,0 +1,57 @@
+package pie
+
+import "golang.org/x/exp/constraints"
+
+// quickselect reorders work so that the elements at the indexes from limit1 up
+// to (but not including) limit2 are the same as if work was sorted. Every
+// element before limit1 is less than or equal to them and every element from
+// limit2 is greater than or equal to them.
+//
+// This implementation aims at linear time O(n) on average. It uses the same
+// idea as QuickSort, but only recurses into the parts that overlap with the
+// limits. See also Quickselect.
+func quickselect[T constraints.Ordered](work []T, limit1, limit2 int) {
+	var rec func(a, b int)
+	rec = func(a, b int) {
+		if b-a <= 1 {
+			return
+		}
+		ipivot := (a + b) / 2
+		pivot := work[ipivot]
+		work[a], work[ipivot] = work[ipivot], work[a]
+		j := a
+		k := b
+		for j+1 < k {
+			if work[j+1] < pivot {
+				work[j+1], work[j] = work[j], work[j+1]
+				j++
+			} else {
+				work[j+1], work[k-1] = work[k-1], work[j+1]
+				k--
+			}
+		}
+		// 1 or 0 recursive calls when limit2 is limit1+1
+		if j > limit1 {
+			rec(a, j)
+		}
+		if j+1 < limit2 {
+			rec(j+1, b)
+		}
+	}
+
+	rec(0, len(work))
+}
+
+// medianFloat64 is the median of work, which will be reordered. work must not
+// be empty.
+func medianFloat64(work []float64) float64 {
+	n := len(work)
+	if n%2 == 1 {
+		quickselect(work, n/2, n/2+1)
+		return work[n/2]
+	}
+
+	quickselect(work, n/2-1, n/2+1)
+
+	return (work[n/2-1] + work[n/2]) / 2
+}
@@ -1,25 +1,26 @@
 package pie
 
 import (
-	"golang.org/x/exp/constraints"
 	"math/rand"
 )
 
-// Random returns a random element by your rand.Source, or zero.
-func Random[T constraints.Integer | constraints.Float](ss []T, source rand.Source) T {
+// Random returns a random element by your rand.Source. If the slice is empty,
+// the zero value and false are returned.
+func Random[T any](ss []T, source rand.Source) (T, bool) {
 	n := len(ss)
 
-	// Avoid the extra allocation.
 	if n < 1 {
-		return 0
+		var zeroValue T
+		return zeroValue, false
 	}
 
+	// Avoid creating the rand.Rand.
 	if n < 2 {
-		return ss[0]
+		return ss[0], true
 	}
 
 	rnd := rand.New(source)
 	i := rnd.Intn(n)
 
-	return ss[i]
+	return ss[i], true
 }
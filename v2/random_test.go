@@ -10,36 +10,43 @@ import (
 var randomTests = []struct {
 	ss       []float64
 	expected float64
+	ok       bool
 	source   rand.Source
 }{
 	{
 		nil,
 		0.0,
+		false,
 		nil,
 	},
 	{
 		nil,
 		0.0,
+		false,
 		rand.NewSource(0),
 	},
 	{
 		[]float64{},
 		0.0,
+		false,
 		rand.NewSource(0),
 	},
 	{
 		[]float64{12.3, 2.34, 4.56},
 		12.3,
+		true,
 		rand.NewSource(0),
 	},
 	{
 		[]float64{12.3, 2.34, 4.56},
 		4.56,
+		true,
 		rand.NewSource(1),
 	},
 	{
 		[]float64{12.3},
 		12.3,
+		true,
 		rand.NewSource(0),
 	},
 }
@@ -47,7 +54,24 @@ var randomTests = []struct {
 func TestRandom(t *testing.T) {
 	for _, test := range randomTests {
 		t.Run("", func(t *testing.T) {
-			assert.Equal(t, test.expected, pie.Random(test.ss, test.source))
+			actual, ok := pie.Random(test.ss, test.source)
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, test.ok, ok)
 		})
 	}
 }
+
+func TestRandomAnyType(t *testing.T) {
+	type car struct {
+		name string
+	}
+
+	cars := []*car{{"a"}, {"b"}, {"c"}}
+	picked, ok := pie.Random(cars, rand.NewSource(0))
+	assert.True(t, ok)
+	assert.Contains(t, cars, picked)
+
+	picked, ok = pie.Random([]*car(nil), rand.NewSource(0))
+	assert.False(t, ok)
+	assert.Nil(t, picked)
+}
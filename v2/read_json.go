@@ -0,0 +1,54 @@
+package pie
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// ReadJSON decodes a JSON array from r. The elements are decoded one at a
+// time, so only the result (and not the input) is held in memory.
+//
+// A JSON null returns a nil slice and an empty array returns an empty slice.
+// Any other value, or anything after the array other than whitespace, is an
+// error. Errors while decoding an element include the index of the element.
+func ReadJSON[T any](r io.Reader) ([]T, error) {
+	decoder := json.NewDecoder(r)
+
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	var ss []T
+	switch token {
+	case nil:
+		// null
+
+	case json.Delim('['):
+		ss = []T{}
+		for decoder.More() {
+			var s T
+			if err := decoder.Decode(&s); err != nil {
+				return nil, fmt.Errorf("element %d: %w", len(ss), err)
+			}
+
+			ss = append(ss, s)
+		}
+
+		// The closing bracket. Any other error would have already been returned
+		// by Decode.
+		if _, err := decoder.Token(); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("expected a JSON array but found %v", token)
+	}
+
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after the JSON array")
+	}
+
+	return ss, nil
+}
@@ -0,0 +1,40 @@
+package pie
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// ReadJSONLines decodes one element from each line of r (also known as JSON
+// Lines or NDJSON). This is the opposite of WriteJSONLines.
+//
+// Blank lines are skipped and the last line does not need to end with a new
+// line. Errors while decoding an element include the line number, starting at
+// 1. An empty input returns a nil slice.
+func ReadJSONLines[T any](r io.Reader) ([]T, error) {
+	reader := bufio.NewReader(r)
+
+	var ss []T
+	for lineNumber := 1; ; lineNumber++ {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+
+		if line := bytes.TrimSpace(line); len(line) > 0 {
+			var s T
+			if err := json.Unmarshal(line, &s); err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+
+			ss = append(ss, s)
+		}
+
+		if err == io.EOF {
+			return ss, nil
+		}
+	}
+}
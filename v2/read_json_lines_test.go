@@ -0,0 +1,37 @@
+package pie_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var readJSONLinesTests = []struct {
+	json     string
+	expected []int
+	err      string
+}{
+	{"", nil, ""},
+	{"\n\n", nil, ""},
+	{"1\n2\n3\n", []int{1, 2, 3}, ""},
+	{"1\r\n\n  2  \n3", []int{1, 2, 3}, ""},
+	{"1\n2\nfoo\n", nil, "line 3: invalid character 'o' in literal false (expecting 'a')"},
+	{"1\n\n2 3\n", nil, "line 3: invalid character '3' after top-level value"},
+}
+
+func TestReadJSONLines(t *testing.T) {
+	for _, test := range readJSONLinesTests {
+		t.Run("", func(t *testing.T) {
+			ss, err := pie.ReadJSONLines[int](strings.NewReader(test.json))
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, test.expected, ss)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package pie_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var readJSONTests = []struct {
+	json     string
+	expected []*jsonPerson
+	err      string
+}{
+	{`null`, nil, ""},
+	{`[]`, []*jsonPerson{}, ""},
+	{" [\n] \n", []*jsonPerson{}, ""},
+	{`[{"name":"Bob","age":23},null,{"name":"Jane"}]`, []*jsonPerson{{"Bob", 23}, nil, {Name: "Jane"}}, ""},
+	{``, nil, "EOF"},
+	{`{}`, nil, "expected a JSON array but found {"},
+	{`"foo"`, nil, "expected a JSON array but found foo"},
+	{`[{"name":"Bob"},{"name":1}]`, nil, "element 1: json: cannot unmarshal number"},
+	{`[{"name":"Bob"}`, nil, "element 1: unexpected end of JSON input"},
+	{`[] []`, nil, "unexpected data after the JSON array"},
+}
+
+func TestReadJSON(t *testing.T) {
+	for _, test := range readJSONTests {
+		t.Run("", func(t *testing.T) {
+			ss, err := pie.ReadJSON[*jsonPerson](strings.NewReader(test.json))
+			if test.err != "" {
+				assert.ErrorContains(t, err, test.err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, test.expected, ss)
+		})
+	}
+}
@@ -7,6 +7,9 @@ package pie
 // panic if the reducer is nil and the slice has more than one element (required
 // to invoke reduce). Otherwise returns result of applying reducer from left to
 // right.
+//
+// Use ReduceOk to distinguish an empty slice from a zero result, or Fold to
+// reduce into a different type with an explicit initial value.
 func Reduce[T any](ss []T, reducer func(T, T) T) (el T) {
 	if len(ss) == 0 {
 		return
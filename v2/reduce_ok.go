@@ -0,0 +1,13 @@
+package pie
+
+// ReduceOk is the same as Reduce except that it also returns false if there
+// are no elements in the slice. This allows a legitimate zero value result to
+// be distinguished from an empty input.
+func ReduceOk[T any](ss []T, reducer func(T, T) T) (T, bool) {
+	if len(ss) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return Reduce(ss, reducer), true
+}
@@ -0,0 +1,32 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReduceOk(t *testing.T) {
+	subtract := func(a, b int) int {
+		return a - b
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		el, ok := pie.ReduceOk([]int{}, subtract)
+		assert.Equal(t, 0, el)
+		assert.False(t, ok)
+	})
+
+	t.Run("zero result", func(t *testing.T) {
+		el, ok := pie.ReduceOk([]int{3, 2, 1}, subtract)
+		assert.Equal(t, 0, el)
+		assert.True(t, ok)
+	})
+
+	t.Run("one element", func(t *testing.T) {
+		el, ok := pie.ReduceOk([]int{7}, subtract)
+		assert.Equal(t, 7, el)
+		assert.True(t, ok)
+	})
+}
@@ -0,0 +1,18 @@
+package pie
+
+// ReduceRight is the same as Reduce except the elements are reduced from right
+// to left. The last element is used as the initial value.
+//
+// Returns a zero value of T if there are no elements in the slice.
+func ReduceRight[T any](ss []T, reducer func(T, T) T) (el T) {
+	if len(ss) == 0 {
+		return
+	}
+
+	el = ss[len(ss)-1]
+	for i := len(ss) - 2; i >= 0; i-- {
+		el = reducer(el, ss[i])
+	}
+
+	return
+}
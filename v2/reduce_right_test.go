@@ -0,0 +1,43 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var reduceRightTests = []struct {
+	ss       []float64
+	expected float64
+	reducer  func(a, b float64) float64
+}{
+	{
+		[]float64{1, 2, 3},
+		6,
+		func(a, b float64) float64 { return a + b },
+	},
+	{
+		[]float64{1, 2, 3},
+		0,
+		func(a, b float64) float64 { return a - b },
+	},
+	{
+		[]float64{},
+		0,
+		func(a, b float64) float64 { return a - b },
+	},
+	{
+		[]float64{1},
+		1,
+		func(a, b float64) float64 { return a - b },
+	},
+}
+
+func TestReduceRight(t *testing.T) {
+	for _, test := range reduceRightTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.ReduceRight(test.ss, test.reducer))
+		})
+	}
+}
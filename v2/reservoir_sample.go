@@ -0,0 +1,42 @@
+package pie
+
+import (
+	"math/rand"
+)
+
+// ReservoirSample returns k elements chosen at random by your rand.Source from
+// all of the values produced by seq, such as an iter.Seq. Each value has the
+// same chance of being chosen. This is useful when the number of values is not
+// known in advance or they do not fit in memory, since only k values are kept.
+//
+// The elements are returned in no particular order. If seq produces k or fewer
+// values they are all returned, and if k is less than 1 nil is returned.
+func ReservoirSample[T any](seq func(yield func(T) bool), k int, source rand.Source) []T {
+	if k < 1 {
+		return nil
+	}
+
+	var rnd *rand.Rand
+	var reservoir []T
+	n := 0
+	seq(func(value T) bool {
+		n++
+		if len(reservoir) < k {
+			reservoir = append(reservoir, value)
+			return true
+		}
+
+		// Avoid creating the rand.Rand until it is needed.
+		if rnd == nil {
+			rnd = rand.New(source)
+		}
+
+		if i := rnd.Intn(n); i < k {
+			reservoir[i] = value
+		}
+
+		return true
+	})
+
+	return reservoir
+}
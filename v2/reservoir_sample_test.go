@@ -0,0 +1,55 @@
+package pie_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func countTo(n int) func(yield func(int) bool) {
+	return func(yield func(int) bool) {
+		for i := 0; i < n; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+var reservoirSampleTests = []struct {
+	n, k     int
+	expected []int
+}{
+	{0, 3, nil},
+	{5, 0, nil},
+	{2, 3, []int{0, 1}},
+	{3, 3, []int{0, 1, 2}},
+	{100, 3, []int{39, 5, 89}},
+}
+
+func TestReservoirSample(t *testing.T) {
+	for _, test := range reservoirSampleTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected,
+				pie.ReservoirSample(countTo(test.n), test.k, rand.NewSource(0)))
+		})
+	}
+}
+
+func TestReservoirSampleDistribution(t *testing.T) {
+	source := rand.NewSource(0)
+	counts := map[int]int{}
+
+	for i := 0; i < 2000; i++ {
+		for _, value := range pie.ReservoirSample(countTo(10), 2, source) {
+			counts[value]++
+		}
+	}
+
+	// Each value is expected 400 times.
+	for i := 0; i < 10; i++ {
+		assert.InDelta(t, 400, counts[i], 80, "value %d", i)
+	}
+}
@@ -1,15 +1,17 @@
 package pie
 
+import "golang.org/x/exp/slices"
+
 // Reverse returns a new copy of the slice with the elements ordered in reverse.
 // This is useful when combined with Sort to get a descending sort order:
 //
 //   ss.Sort().Reverse()
 //
 func Reverse[T any](ss []T) []T {
-	// Avoid the allocation. If there is one element or less it is already
-	// reversed.
+	// There is nothing to reverse with one element or less, but the result must
+	// still be a copy.
 	if len(ss) < 2 {
-		return ss
+		return slices.Clone(ss)
 	}
 
 	sorted := make([]T, len(ss))
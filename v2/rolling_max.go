@@ -0,0 +1,57 @@
+package pie
+
+import "golang.org/x/exp/constraints"
+
+// RollingMax returns the maximum value of each window of consecutive elements.
+//
+// The result contains one value for every complete window, that is
+// len(ss)-window+1 values. nil is returned if the slice has fewer elements
+// than the window. It will panic if window is less than 1.
+//
+// This runs in O(n) regardless of the window size.
+//
+// Examples:
+//
+//	RollingMax([4, 2, 12, 3, 8], 2) => [4, 12, 12, 8]
+//	RollingMax([4, 2, 12, 3, 8], 3) => [12, 12, 12]
+func RollingMax[T constraints.Ordered](ss []T, window int) []T {
+	return rollingExtreme(ss, window, func(a, b T) bool {
+		return a >= b
+	})
+}
+
+// rollingExtreme implements RollingMin and RollingMax using a monotonic deque.
+// The deque holds indexes into ss whose values are ordered by keep, so the
+// front of the deque is always the extreme value of the current window.
+func rollingExtreme[T any](ss []T, window int, keep func(a, b T) bool) []T {
+	if window <= 0 {
+		panic("window should be greater than 0")
+	}
+
+	if len(ss) < window {
+		return nil
+	}
+
+	result := make([]T, len(ss)-window+1)
+	deque := make([]int, 0, window)
+
+	for i, s := range ss {
+		// Drop the front if it has moved out of the window.
+		if len(deque) > 0 && deque[0] <= i-window {
+			deque = deque[1:]
+		}
+
+		// Anything at the back that is superseded by the new value can never be
+		// the extreme of this or any later window.
+		for len(deque) > 0 && !keep(ss[deque[len(deque)-1]], s) {
+			deque = deque[:len(deque)-1]
+		}
+		deque = append(deque, i)
+
+		if i >= window-1 {
+			result[i-window+1] = ss[deque[0]]
+		}
+	}
+
+	return result
+}
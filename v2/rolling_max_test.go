@@ -0,0 +1,37 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var rollingMaxTests = []struct {
+	ss       []int
+	window   int
+	expected []int
+}{
+	{nil, 1, nil},
+	{[]int{1, 2}, 3, nil},
+	{[]int{4, 2, 12, 3, 8}, 1, []int{4, 2, 12, 3, 8}},
+	{[]int{4, 2, 12, 3, 8}, 2, []int{4, 12, 12, 8}},
+	{[]int{4, 2, 12, 3, 8}, 3, []int{12, 12, 12}},
+	{[]int{1, 2, 3, 4, 5}, 3, []int{3, 4, 5}},
+	{[]int{5, 4, 3, 2, 1}, 3, []int{5, 4, 3}},
+	{[]int{1, 1, 3, 3, 3}, 2, []int{1, 3, 3, 3}},
+}
+
+func TestRollingMax(t *testing.T) {
+	for _, test := range rollingMaxTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.RollingMax(test.ss, test.window))
+		})
+	}
+
+	assert.Equal(t, []string{"d", "d"}, pie.RollingMax([]string{"c", "a", "d", "b"}, 3))
+
+	assert.PanicsWithValue(t, "window should be greater than 0", func() {
+		pie.RollingMax([]int{1, 2, 3}, 0)
+	})
+}
@@ -0,0 +1,41 @@
+package pie
+
+import "golang.org/x/exp/constraints"
+
+// RollingMean returns the average of each window of consecutive elements. This
+// is also known as the simple moving average.
+//
+// The result contains one value for every complete window, that is
+// len(ss)-window+1 values. nil is returned if the slice has fewer elements
+// than the window. It will panic if window is less than 1.
+//
+// Examples:
+//
+//	RollingMean([1, 2, 3, 4, 5], 2) => [1.5, 2.5, 3.5, 4.5]
+//	RollingMean([1, 2, 3, 4, 5], 5) => [3]
+func RollingMean[T constraints.Integer | constraints.Float](ss []T, window int) []float64 {
+	if window <= 0 {
+		panic("window should be greater than 0")
+	}
+
+	if len(ss) < window {
+		return nil
+	}
+
+	result := make([]float64, len(ss)-window+1)
+
+	// The running sum is kept as a float64 so that small integer types do not
+	// overflow for large windows.
+	var sum float64
+	for i, s := range ss {
+		sum += float64(s)
+		if i >= window {
+			sum -= float64(ss[i-window])
+		}
+		if i >= window-1 {
+			result[i-window+1] = sum / float64(window)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,32 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var rollingMeanTests = []struct {
+	ss       []int8
+	window   int
+	expected []float64
+}{
+	{nil, 1, nil},
+	{[]int8{1, 2}, 3, nil},
+	{[]int8{1, 2, 3, 4, 5}, 2, []float64{1.5, 2.5, 3.5, 4.5}},
+	{[]int8{1, 2, 3, 4, 5}, 5, []float64{3}},
+	{[]int8{100, 100, 100, 100}, 3, []float64{100, 100}},
+}
+
+func TestRollingMean(t *testing.T) {
+	for _, test := range rollingMeanTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.RollingMean(test.ss, test.window))
+		})
+	}
+
+	assert.PanicsWithValue(t, "window should be greater than 0", func() {
+		pie.RollingMean([]int8{1, 2, 3}, -1)
+	})
+}
@@ -0,0 +1,89 @@
+package pie
+
+import "golang.org/x/exp/constraints"
+
+// RollingMedian returns the median of each window of consecutive elements. See
+// Median for how the median is calculated for an even sized window.
+//
+// The result contains one value for every complete window, that is
+// len(ss)-window+1 values. nil is returned if the slice has fewer elements
+// than the window. It will panic if window is less than 1.
+//
+// The median of a window that contains NaN is NaN.
+//
+// Examples:
+//
+//	RollingMedian([1, 5, 2, 8, 3], 3) => [2, 5, 3]
+//	RollingMedian([1, 5, 2, 8, 3], 2) => [3, 3, 5, 5]
+func RollingMedian[T constraints.Integer | constraints.Float](ss []T, window int) []T {
+	if window <= 0 {
+		panic("window should be greater than 0")
+	}
+
+	if len(ss) < window {
+		return nil
+	}
+
+	result := make([]T, len(ss)-window+1)
+
+	// sorted always contains the elements of the current window in ascending
+	// order. Each step removes the element leaving the window and inserts the
+	// one entering it, rather than sorting every window from scratch.
+	sorted := make([]T, 0, window)
+
+	search := func(value T) int {
+		lo, hi := 0, len(sorted)
+		for lo < hi {
+			mid := (lo + hi) / 2
+			if sorted[mid] < value {
+				lo = mid + 1
+			} else {
+				hi = mid
+			}
+		}
+
+		return lo
+	}
+
+	// NaNs cannot be ordered, so they are counted instead of being added to
+	// sorted.
+	nans := 0
+	var nan T
+
+	for i, s := range ss {
+		if i >= window {
+			if out := ss[i-window]; out != out {
+				nans--
+			} else {
+				j := search(out)
+				sorted = append(sorted[:j], sorted[j+1:]...)
+			}
+		}
+
+		if s != s {
+			nans++
+			nan = s
+		} else {
+			j := search(s)
+			sorted = append(sorted, s)
+			copy(sorted[j+1:], sorted[j:])
+			sorted[j] = s
+		}
+
+		switch {
+		case i < window-1:
+			// The first window is not complete yet.
+
+		case nans > 0:
+			result[i-window+1] = nan
+
+		case window%2 == 1:
+			result[i-window+1] = sorted[window/2]
+
+		default:
+			result[i-window+1] = (sorted[window/2-1] + sorted[window/2]) / 2
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,50 @@
+package pie_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var rollingMedianTests = []struct {
+	ss       []float64
+	window   int
+	expected []float64
+}{
+	{nil, 1, nil},
+	{[]float64{1, 2}, 3, nil},
+	{[]float64{1, 5, 2, 8, 3}, 1, []float64{1, 5, 2, 8, 3}},
+	{[]float64{1, 5, 2, 8, 3}, 2, []float64{3, 3.5, 5, 5.5}},
+	{[]float64{1, 5, 2, 8, 3}, 3, []float64{2, 5, 3}},
+	{[]float64{1, 5, 2, 8, 3}, 5, []float64{3}},
+	{[]float64{2, 2, 2, 1, 1, 1}, 3, []float64{2, 2, 1, 1}},
+}
+
+func TestRollingMedian(t *testing.T) {
+	for _, test := range rollingMedianTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.RollingMedian(test.ss, test.window))
+
+			// Every window must agree with Median.
+			for i, median := range pie.RollingMedian(test.ss, test.window) {
+				assert.Equal(t, pie.Median(test.ss[i:i+test.window]), median)
+			}
+		})
+	}
+
+	assert.PanicsWithValue(t, "window should be greater than 0", func() {
+		pie.RollingMedian([]int{1, 2, 3}, 0)
+	})
+}
+
+func TestRollingMedianNaN(t *testing.T) {
+	medians := pie.RollingMedian([]float64{1, 5, math.NaN(), 0, 0}, 2)
+
+	assert.Len(t, medians, 4)
+	assert.Equal(t, 3.0, medians[0])
+	assert.True(t, math.IsNaN(medians[1]))
+	assert.True(t, math.IsNaN(medians[2]))
+	assert.Equal(t, 0.0, medians[3])
+}
@@ -0,0 +1,21 @@
+package pie
+
+import "golang.org/x/exp/constraints"
+
+// RollingMin returns the minimum value of each window of consecutive elements.
+//
+// The result contains one value for every complete window, that is
+// len(ss)-window+1 values. nil is returned if the slice has fewer elements
+// than the window. It will panic if window is less than 1.
+//
+// This runs in O(n) regardless of the window size.
+//
+// Examples:
+//
+//	RollingMin([4, 2, 12, 3, 8], 2) => [2, 2, 3, 3]
+//	RollingMin([4, 2, 12, 3, 8], 3) => [2, 2, 3]
+func RollingMin[T constraints.Ordered](ss []T, window int) []T {
+	return rollingExtreme(ss, window, func(a, b T) bool {
+		return a <= b
+	})
+}
@@ -0,0 +1,37 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var rollingMinTests = []struct {
+	ss       []int
+	window   int
+	expected []int
+}{
+	{nil, 1, nil},
+	{[]int{1, 2}, 3, nil},
+	{[]int{4, 2, 12, 3, 8}, 1, []int{4, 2, 12, 3, 8}},
+	{[]int{4, 2, 12, 3, 8}, 2, []int{2, 2, 3, 3}},
+	{[]int{4, 2, 12, 3, 8}, 3, []int{2, 2, 3}},
+	{[]int{1, 2, 3, 4, 5}, 3, []int{1, 2, 3}},
+	{[]int{5, 4, 3, 2, 1}, 3, []int{3, 2, 1}},
+	{[]int{3, 3, 3, 1, 1}, 2, []int{3, 3, 1, 1}},
+}
+
+func TestRollingMin(t *testing.T) {
+	for _, test := range rollingMinTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.RollingMin(test.ss, test.window))
+		})
+	}
+
+	assert.Equal(t, []string{"a", "a", "b"}, pie.RollingMin([]string{"c", "a", "d", "b", "e"}, 3))
+
+	assert.PanicsWithValue(t, "window should be greater than 0", func() {
+		pie.RollingMin([]int{1, 2, 3}, 0)
+	})
+}
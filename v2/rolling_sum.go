@@ -0,0 +1,39 @@
+package pie
+
+import "golang.org/x/exp/constraints"
+
+// RollingSum returns the sum of each window of consecutive elements.
+//
+// The result contains one value for every complete window, that is
+// len(ss)-window+1 values. nil is returned if the slice has fewer elements
+// than the window. It will panic if window is less than 1.
+//
+// Examples:
+//
+//	RollingSum([1, 2, 3, 4, 5], 2) => [3, 5, 7, 9]
+//	RollingSum([1, 2, 3, 4, 5], 3) => [6, 9, 12]
+//	RollingSum([1, 2], 3)          => []
+func RollingSum[T constraints.Integer | constraints.Float](ss []T, window int) []T {
+	if window <= 0 {
+		panic("window should be greater than 0")
+	}
+
+	if len(ss) < window {
+		return nil
+	}
+
+	result := make([]T, len(ss)-window+1)
+
+	var sum T
+	for i, s := range ss {
+		sum += s
+		if i >= window {
+			sum -= ss[i-window]
+		}
+		if i >= window-1 {
+			result[i-window+1] = sum
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,33 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var rollingSumTests = []struct {
+	ss       []int
+	window   int
+	expected []int
+}{
+	{nil, 1, nil},
+	{[]int{1, 2}, 3, nil},
+	{[]int{1, 2, 3, 4, 5}, 1, []int{1, 2, 3, 4, 5}},
+	{[]int{1, 2, 3, 4, 5}, 2, []int{3, 5, 7, 9}},
+	{[]int{1, 2, 3, 4, 5}, 3, []int{6, 9, 12}},
+	{[]int{1, 2, 3, 4, 5}, 5, []int{15}},
+}
+
+func TestRollingSum(t *testing.T) {
+	for _, test := range rollingSumTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.RollingSum(test.ss, test.window))
+		})
+	}
+
+	assert.PanicsWithValue(t, "window should be greater than 0", func() {
+		pie.RollingSum([]int{1, 2, 3}, 0)
+	})
+}
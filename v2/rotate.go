@@ -1,5 +1,7 @@
 package pie
 
+import "golang.org/x/exp/slices"
+
 // Rotate return slice circularly rotated by a number of positions n.
 // If n is positive, the slice is rotated right.
 // If n is negative, the slice is rotated left.
@@ -7,10 +9,9 @@ func Rotate[T any](ss []T, n int) []T {
 
 	length := len(ss)
 
-	// Avoid the allocation.
 	// If there is one element or less, then already rotated.
 	if length < 2 {
-		return ss
+		return slices.Clone(ss)
 	}
 
 	// Normalize shift
@@ -20,10 +21,9 @@ func Rotate[T any](ss []T, n int) []T {
 		shift = length + shift
 	}
 
-	// Avoid the allocation.
 	// If normalized shift is 0, then already rotated.
 	if shift == 0 {
-		return ss
+		return slices.Clone(ss)
 	}
 
 	return append(DropTop(ss, shift), Top(ss, shift)...)
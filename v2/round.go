@@ -0,0 +1,79 @@
+package pie
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Round returns a new slice with each element rounded to the number of decimal
+// places, with halves rounded away from zero:
+//
+//	Round([1.234, 5.678, -2.5], 1) => [1.2, 5.7, -2.5]
+//	Round([1.234, 5.678, -2.5], 0) => [1, 6, -3]
+//
+// A negative number of places rounds to the left of the decimal point, so it
+// also works for integers:
+//
+//	Round([1234, 1250, -1250], -2) => [1200, 1300, -1300]
+//
+// Integers are unchanged when places is zero or more. Rounded integers that
+// are too large for T wrap around, the same as other integer arithmetic. nil is
+// returned if there are no elements in the slice.
+func Round[T constraints.Integer | constraints.Float](ss []T, places int) []T {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	result := make([]T, len(ss))
+	isFloat := T(1)/2 != 0
+
+	switch {
+	case isFloat:
+		pow := math.Pow(10, float64(places))
+		for i, s := range ss {
+			scaled := float64(s) * pow
+			switch {
+			case math.IsInf(scaled, 0) || math.IsNaN(scaled):
+				// It is already more precise than places, or it is not a
+				// number.
+				result[i] = s
+
+			case pow == 0:
+				// Rounding to more than 10^308 is always zero.
+
+			default:
+				result[i] = T(math.Round(scaled) / pow)
+			}
+		}
+
+	case places >= 0:
+		copy(result, ss)
+
+	default:
+		// factor is 10^-places, unless it is too large for T. In that case every
+		// element rounds to zero.
+		factor := T(1)
+		for i := 0; i < -places; i++ {
+			if factor*10/10 != factor {
+				return result
+			}
+
+			factor *= 10
+		}
+
+		for i, s := range ss {
+			quotient := s / factor
+			remainder := s - quotient*factor
+			if s >= 0 && remainder >= factor-remainder {
+				quotient++
+			} else if s < 0 && -remainder >= factor+remainder {
+				quotient--
+			}
+
+			result[i] = quotient * factor
+		}
+	}
+
+	return result
+}
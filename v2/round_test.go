@@ -0,0 +1,55 @@
+package pie_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var roundTests = []struct {
+	ss       []float64
+	places   int
+	expected []float64
+}{
+	{nil, 2, nil},
+	{[]float64{1.234, 5.678, -2.5}, 1, []float64{1.2, 5.7, -2.5}},
+	{[]float64{1.234, 5.678, -2.5}, 0, []float64{1, 6, -3}},
+	{[]float64{1.005, 2.675}, 2, []float64{1, 2.68}},
+	{[]float64{1234.5, -1250}, -2, []float64{1200, -1300}},
+	{[]float64{1e300, 0, math.Inf(1)}, 400, []float64{1e300, 0, math.Inf(1)}},
+	{[]float64{123, math.Inf(-1)}, -400, []float64{0, math.Inf(-1)}},
+}
+
+func TestRound(t *testing.T) {
+	for _, test := range roundTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.Round(test.ss, test.places))
+		})
+	}
+}
+
+var roundIntegersTests = []struct {
+	ss       []int
+	places   int
+	expected []int
+}{
+	{[]int{1234, 1250, -1250, -1249}, -2, []int{1200, 1300, -1300, -1200}},
+	{[]int{1234, 5}, 0, []int{1234, 5}},
+	{[]int{1234, 5}, 3, []int{1234, 5}},
+	{[]int{15, 14, -15, -14}, -1, []int{20, 10, -20, -10}},
+	{[]int{123}, -25, []int{0}},
+}
+
+func TestRoundIntegers(t *testing.T) {
+	for _, test := range roundIntegersTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.Round(test.ss, test.places))
+		})
+	}
+
+	assert.Equal(t, []uint8{200, 0}, pie.Round([]uint8{249, 49}, -2))
+	assert.Equal(t, []int8{100, -100}, pie.Round([]int8{50, -50}, -2))
+	assert.Equal(t, []int8{0}, pie.Round([]int8{127}, -3))
+}
@@ -0,0 +1,30 @@
+package pie
+
+// RunLengthDecode is the opposite of RunLengthEncode. It returns a new slice
+// with the Value of each Run repeated Count times:
+//
+//	RunLengthDecode([{a, 2}, {b, 1}]) => [a, a, b]
+//
+// Runs with a Count of zero or less are skipped. nil is returned if there are
+// no elements.
+func RunLengthDecode[T any](runs []Run[T]) []T {
+	n := 0
+	for _, run := range runs {
+		if run.Count > 0 {
+			n += run.Count
+		}
+	}
+
+	if n == 0 {
+		return nil
+	}
+
+	result := make([]T, 0, n)
+	for _, run := range runs {
+		for i := 0; i < run.Count; i++ {
+			result = append(result, run.Value)
+		}
+	}
+
+	return result
+}
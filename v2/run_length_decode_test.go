@@ -0,0 +1,26 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var runLengthDecodeTests = []struct {
+	runs     []pie.Run[string]
+	expected []string
+}{
+	{nil, nil},
+	{[]pie.Run[string]{{"a", 0}, {"b", -1}}, nil},
+	{[]pie.Run[string]{{"a", 2}, {"b", 1}}, []string{"a", "a", "b"}},
+	{[]pie.Run[string]{{"a", 1}, {"b", -2}, {"a", 2}}, []string{"a", "a", "a"}},
+}
+
+func TestRunLengthDecode(t *testing.T) {
+	for _, test := range runLengthDecodeTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.RunLengthDecode(test.runs))
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package pie
+
+// Run is a value that is repeated Count times in a row. See RunLengthEncode.
+type Run[T any] struct {
+	Value T
+	Count int
+}
+
+// RunLengthEncode returns a Run for each run of consecutive equal elements:
+//
+//	RunLengthEncode([a, a, b, a, a, a]) => [{a, 2}, {b, 1}, {a, 3}]
+//
+// Use RunLengthDecode to get the original elements back. nil is returned if
+// there are no elements.
+func RunLengthEncode[T comparable](ss []T) []Run[T] {
+	var runs []Run[T]
+	for i, s := range ss {
+		if i > 0 && s == ss[i-1] {
+			runs[len(runs)-1].Count++
+		} else {
+			runs = append(runs, Run[T]{s, 1})
+		}
+	}
+
+	return runs
+}
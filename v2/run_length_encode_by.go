@@ -0,0 +1,23 @@
+package pie
+
+// RunLengthEncodeBy works like RunLengthEncode, except that consecutive
+// elements are equal when they have the same key. The Value of each Run is the
+// first element of the run:
+//
+//	RunLengthEncodeBy([1, 3, 4, 6, 8, 5], isEven) => [{1, 2}, {4, 3}, {5, 1}]
+//
+// The key function is called once for each element.
+func RunLengthEncodeBy[T any, K comparable](ss []T, key func(T) K) []Run[T] {
+	var runs []Run[T]
+	var previous K
+	for i, s := range ss {
+		if k := key(s); i > 0 && k == previous {
+			runs[len(runs)-1].Count++
+		} else {
+			runs = append(runs, Run[T]{s, 1})
+			previous = k
+		}
+	}
+
+	return runs
+}
@@ -0,0 +1,30 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func isEven(i int) bool {
+	return i%2 == 0
+}
+
+var runLengthEncodeByTests = []struct {
+	ss       []int
+	expected []pie.Run[int]
+}{
+	{nil, nil},
+	{[]int{0}, []pie.Run[int]{{0, 1}}},
+	{[]int{1, 3, 4, 6, 8, 5}, []pie.Run[int]{{1, 2}, {4, 3}, {5, 1}}},
+	{[]int{2, 4, 6}, []pie.Run[int]{{2, 3}}},
+}
+
+func TestRunLengthEncodeBy(t *testing.T) {
+	for _, test := range runLengthEncodeByTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.RunLengthEncodeBy(test.ss, isEven))
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var runLengthEncodeTests = []struct {
+	ss       []string
+	expected []pie.Run[string]
+}{
+	{nil, nil},
+	{[]string{"a"}, []pie.Run[string]{{"a", 1}}},
+	{[]string{"a", "a", "b", "a", "a", "a"}, []pie.Run[string]{{"a", 2}, {"b", 1}, {"a", 3}}},
+	{[]string{"a", "b", "c"}, []pie.Run[string]{{"a", 1}, {"b", 1}, {"c", 1}}},
+}
+
+func TestRunLengthEncode(t *testing.T) {
+	for _, test := range runLengthEncodeTests {
+		t.Run("", func(t *testing.T) {
+			runs := pie.RunLengthEncode(test.ss)
+			assert.Equal(t, test.expected, runs)
+			assert.Equal(t, test.ss, pie.RunLengthDecode(runs))
+		})
+	}
+}
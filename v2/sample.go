@@ -0,0 +1,49 @@
+package pie
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// Sample returns k different elements chosen at random by your rand.Source.
+// Each element can only be chosen once, although equal values may appear more
+// than once if they are in the input more than once.
+//
+// The chosen elements are returned in the same order that they appear in ss.
+// If k is greater than or equal to the length of ss a copy of ss is returned,
+// and if k is less than 1 nil is returned.
+//
+// Sample uses Floyd's algorithm, so it only needs k random numbers regardless
+// of the length of ss.
+func Sample[T any](ss []T, k int, source rand.Source) []T {
+	n := len(ss)
+	if k < 1 || n == 0 {
+		return nil
+	}
+
+	if k >= n {
+		return append(make([]T, 0, n), ss...)
+	}
+
+	rnd := rand.New(source)
+	chosen := make(map[int]struct{}, k)
+	indexes := make([]int, 0, k)
+	for j := n - k; j < n; j++ {
+		i := rnd.Intn(j + 1)
+		if _, ok := chosen[i]; ok {
+			i = j
+		}
+
+		chosen[i] = struct{}{}
+		indexes = append(indexes, i)
+	}
+
+	sort.Ints(indexes)
+
+	sample := make([]T, k)
+	for i, index := range indexes {
+		sample[i] = ss[index]
+	}
+
+	return sample
+}
@@ -0,0 +1,52 @@
+package pie_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var sampleTests = []struct {
+	ss       []string
+	k        int
+	expected []string
+}{
+	{nil, 2, nil},
+	{[]string{"a", "b"}, 0, nil},
+	{[]string{"a", "b"}, -1, nil},
+	{[]string{"a", "b"}, 2, []string{"a", "b"}},
+	{[]string{"a", "b"}, 5, []string{"a", "b"}},
+	{[]string{"a", "b", "c", "d", "e"}, 3, []string{"a", "c", "d"}},
+}
+
+func TestSample(t *testing.T) {
+	for _, test := range sampleTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.Sample(test.ss, test.k, rand.NewSource(0)))
+		})
+	}
+}
+
+func TestSampleChoosesEachElementOnce(t *testing.T) {
+	ss := pie.Sequence([]int{}, 20)
+	source := rand.NewSource(0)
+	counts := map[int]int{}
+
+	for i := 0; i < 2000; i++ {
+		sample := pie.Sample(ss, 5, source)
+		assert.Len(t, sample, 5)
+		assert.True(t, pie.AreSorted(sample))
+		assert.True(t, pie.AreUnique(sample))
+
+		for _, s := range sample {
+			counts[s]++
+		}
+	}
+
+	// Each element is expected 500 times.
+	for _, s := range ss {
+		assert.InDelta(t, 500, counts[s], 100, "element %d", s)
+	}
+}
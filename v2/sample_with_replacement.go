@@ -0,0 +1,24 @@
+package pie
+
+import (
+	"math/rand"
+)
+
+// SampleWithReplacement returns k elements chosen at random by your
+// rand.Source. Unlike Sample, the same element may be chosen more than once and
+// k can be greater than the length of ss.
+//
+// If ss is empty or k is less than 1, nil is returned.
+func SampleWithReplacement[T any](ss []T, k int, source rand.Source) []T {
+	if k < 1 || len(ss) == 0 {
+		return nil
+	}
+
+	rnd := rand.New(source)
+	sample := make([]T, k)
+	for i := range sample {
+		sample[i] = ss[rnd.Intn(len(ss))]
+	}
+
+	return sample
+}
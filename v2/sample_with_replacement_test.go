@@ -0,0 +1,29 @@
+package pie_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var sampleWithReplacementTests = []struct {
+	ss       []string
+	k        int
+	expected []string
+}{
+	{nil, 2, nil},
+	{[]string{"a", "b"}, 0, nil},
+	{[]string{"a"}, 3, []string{"a", "a", "a"}},
+	{[]string{"a", "b", "c", "d", "e"}, 4, []string{"e", "e", "d", "b"}},
+}
+
+func TestSampleWithReplacement(t *testing.T) {
+	for _, test := range sampleWithReplacementTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected,
+				pie.SampleWithReplacement(test.ss, test.k, rand.NewSource(0)))
+		})
+	}
+}
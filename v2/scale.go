@@ -0,0 +1,32 @@
+package pie
+
+import "golang.org/x/exp/constraints"
+
+// Scale returns a new slice with each element multiplied by factor:
+//
+//	Scale([1, 2, 3], 10) => [10, 20, 30]
+//
+// nil is returned if there are no elements.
+func Scale[T constraints.Integer | constraints.Float](ss []T, factor T) []T {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	result := make([]T, len(ss))
+
+	// Unrolled in the same way as Add.
+	i := 0
+	for ; i+4 <= len(result); i += 4 {
+		r, a := result[i:i+4:i+4], ss[i:i+4:i+4]
+		r[0] = a[0] * factor
+		r[1] = a[1] * factor
+		r[2] = a[2] * factor
+		r[3] = a[3] * factor
+	}
+
+	for ; i < len(result); i++ {
+		result[i] = ss[i] * factor
+	}
+
+	return result
+}
@@ -0,0 +1,26 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var scaleTests = []struct {
+	ss       []float64
+	factor   float64
+	expected []float64
+}{
+	{nil, 2, nil},
+	{[]float64{1, 2, 3}, 10, []float64{10, 20, 30}},
+	{[]float64{1, 2, 3, 4, 5}, -0.5, []float64{-0.5, -1, -1.5, -2, -2.5}},
+}
+
+func TestScale(t *testing.T) {
+	for _, test := range scaleTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.Scale(test.ss, test.factor))
+		})
+	}
+}
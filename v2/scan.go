@@ -0,0 +1,28 @@
+package pie
+
+// Scan is like Reduce, but returns every intermediate accumulator rather than
+// only the final one. The accumulator starts at initial and may be a different
+// type to the elements.
+//
+// The result has the same length as ss, where each value is the accumulator
+// after applying fn to the element at the same position. The initial value is
+// not included. nil is returned if there are no elements in the slice.
+//
+// Examples:
+//
+//	Scan([1, 2, 3], 10, +)                  => [11, 13, 16]
+//	Scan(["a", "b", "c"], "", concatenate)  => ["a", "ab", "abc"]
+func Scan[T, A any](ss []T, initial A, fn func(A, T) A) []A {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	result := make([]A, len(ss))
+	acc := initial
+	for i, s := range ss {
+		acc = fn(acc, s)
+		result[i] = acc
+	}
+
+	return result
+}
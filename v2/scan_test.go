@@ -0,0 +1,25 @@
+package pie_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScan(t *testing.T) {
+	add := func(a, b int) int {
+		return a + b
+	}
+
+	assert.Nil(t, pie.Scan(nil, 10, add))
+	assert.Nil(t, pie.Scan([]int{}, 10, add))
+	assert.Equal(t, []int{11}, pie.Scan([]int{1}, 10, add))
+	assert.Equal(t, []int{11, 13, 16}, pie.Scan([]int{1, 2, 3}, 10, add))
+
+	assert.Equal(t, []string{"1", "12", "123"},
+		pie.Scan([]int{1, 2, 3}, "", func(acc string, i int) string {
+			return acc + strconv.Itoa(i)
+		}))
+}
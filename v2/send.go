@@ -2,6 +2,8 @@ package pie
 
 import (
 	"context"
+
+	"golang.org/x/exp/slices"
 )
 
 // Send sends elements to channel
@@ -14,11 +16,11 @@ func Send[T any](ctx context.Context, ss []T, ch chan<- T) []T {
 	for i, s := range ss {
 		select {
 		case <-ctx.Done():
-			return ss[:i]
+			return slices.Clone(ss[:i])
 		default:
 			ch <- s
 		}
 	}
 
-	return ss
+	return slices.Clone(ss)
 }
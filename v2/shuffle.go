@@ -2,6 +2,8 @@ package pie
 
 import (
 	"math/rand"
+
+	"golang.org/x/exp/slices"
 )
 
 // Shuffle returns a new shuffled slice by your rand.Source. The original slice
@@ -9,9 +11,10 @@ import (
 func Shuffle[T any](ss []T, source rand.Source) []T {
 	n := len(ss)
 
-	// Avoid the extra allocation.
+	// There is nothing to shuffle with one element or less, but the result must
+	// still be a copy.
 	if n < 2 {
-		return ss
+		return slices.Clone(ss)
 	}
 
 	shuffled := make([]T, n)
@@ -0,0 +1,58 @@
+package pie
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+)
+
+// ShuffleSecure returns a new shuffled slice using crypto/rand. It is suitable
+// for shuffles that must not be predictable, such as lottery draws. Each order
+// is equally likely. The original slice is not modified.
+//
+// An error is only returned if crypto/rand fails.
+func ShuffleSecure[T any](ss []T) ([]T, error) {
+	if ss == nil {
+		return nil, nil
+	}
+
+	shuffled := make([]T, len(ss))
+	copy(shuffled, ss)
+
+	source := &secureSource{}
+	source.pos = len(source.buf)
+	shuffleUint64(shuffled, source)
+
+	if source.err != nil {
+		return nil, source.err
+	}
+
+	return shuffled, nil
+}
+
+// secureSource reads random numbers from crypto/rand. They are read in blocks
+// to avoid reading from the system for every number.
+type secureSource struct {
+	buf [256]byte
+	pos int
+	err error
+}
+
+func (s *secureSource) Uint64() uint64 {
+	if s.err != nil {
+		return 0
+	}
+
+	if s.pos == len(s.buf) {
+		if _, s.err = io.ReadFull(rand.Reader, s.buf[:]); s.err != nil {
+			return 0
+		}
+
+		s.pos = 0
+	}
+
+	n := binary.LittleEndian.Uint64(s.buf[s.pos:])
+	s.pos += 8
+
+	return n
+}
@@ -0,0 +1,42 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShuffleSecure(t *testing.T) {
+	shuffled, err := pie.ShuffleSecure([]int(nil))
+	require.NoError(t, err)
+	assert.Nil(t, shuffled)
+
+	shuffled, err = pie.ShuffleSecure([]int{})
+	require.NoError(t, err)
+	assert.Equal(t, []int{}, shuffled)
+
+	ss := pie.Sequence([]int{}, 100)
+	shuffled, err = pie.ShuffleSecure(ss)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, ss, shuffled)
+	assert.NotEqual(t, ss, shuffled)
+}
+
+func TestShuffleSecureDistribution(t *testing.T) {
+	counts := map[[3]int]int{}
+
+	for i := 0; i < 6000; i++ {
+		shuffled, err := pie.ShuffleSecure([]int{1, 2, 3})
+		require.NoError(t, err)
+		counts[[3]int{shuffled[0], shuffled[1], shuffled[2]}]++
+	}
+
+	// Each of the 6 orders is expected 1000 times. The tolerance is more than
+	// 6 standard deviations.
+	assert.Len(t, counts, 6)
+	for order, count := range counts {
+		assert.InDelta(t, 1000, count, 200, "%v", order)
+	}
+}
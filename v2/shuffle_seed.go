@@ -0,0 +1,29 @@
+package pie
+
+// ShuffleSeed returns a new shuffled slice that is always the same for the
+// same seed. The original slice is not modified.
+//
+// Unlike Shuffle, the order does not depend on the math/rand implementation.
+// It uses SplitMix64 for the random numbers, Lemire's method to choose each
+// index and a Fisher-Yates shuffle (swapping from the last element down). The
+// order for a seed and slice length will not change between versions of pie
+// or Go, so it is safe to use in tests or to store the seed to reproduce a
+// shuffle later.
+func ShuffleSeed[T any](ss []T, seed uint64) []T {
+	source := splitMix64(seed)
+
+	return ShuffleWith(ss, &source)
+}
+
+// splitMix64 is the SplitMix64 generator. It must not be changed because
+// ShuffleSeed promises the same results for the same seed.
+type splitMix64 uint64
+
+func (s *splitMix64) Uint64() uint64 {
+	*s += 0x9e3779b97f4a7c15
+	z := uint64(*s)
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+
+	return z ^ (z >> 31)
+}
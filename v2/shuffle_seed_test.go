@@ -0,0 +1,37 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// These results must never change, ShuffleSeed promises that the same seed
+// always gives the same order.
+var shuffleSeedTests = []struct {
+	ss       []int
+	seed     uint64
+	expected []int
+}{
+	{nil, 0, nil},
+	{[]int{}, 0, []int{}},
+	{[]int{1}, 0, []int{1}},
+	{[]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 0, []int{5, 10, 3, 6, 2, 8, 7, 1, 4, 9}},
+	{[]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 1, []int{10, 1, 2, 5, 9, 3, 4, 8, 7, 6}},
+	{[]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 42, []int{9, 4, 7, 6, 5, 1, 10, 3, 2, 8}},
+}
+
+func TestShuffleSeed(t *testing.T) {
+	for _, test := range shuffleSeedTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.ShuffleSeed(test.ss, test.seed))
+		})
+	}
+}
+
+func TestShuffleSeedDifferentTypes(t *testing.T) {
+	// The order only depends on the seed and the length.
+	assert.Equal(t, []string{"e", "j", "c", "f", "b", "h", "g", "a", "d", "i"},
+		pie.ShuffleSeed([]string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"}, 0))
+}
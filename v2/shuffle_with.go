@@ -0,0 +1,54 @@
+package pie
+
+import (
+	"math/bits"
+)
+
+// Uint64Source is a source of uniformly distributed random numbers. It is
+// implemented by the sources in math/rand/v2 (such as rand.PCG and
+// rand.ChaCha8) and by *rand.Rand from math/rand/v2. rand.NewSource in
+// math/rand is declared to return a rand.Source, so it must be converted with
+// a type assertion:
+//
+//	pie.ShuffleWith(ss, rand.NewSource(1).(rand.Source64))
+type Uint64Source interface {
+	Uint64() uint64
+}
+
+// ShuffleWith returns a new shuffled slice by your Uint64Source. The original
+// slice is not modified. Unlike Shuffle, it does not need to create a
+// rand.Rand for each call.
+func ShuffleWith[T any](ss []T, source Uint64Source) []T {
+	if ss == nil {
+		return nil
+	}
+
+	shuffled := make([]T, len(ss))
+	copy(shuffled, ss)
+
+	shuffleUint64(shuffled, source)
+
+	return shuffled
+}
+
+// shuffleUint64 is a Fisher-Yates shuffle of ss in place.
+func shuffleUint64[T any](ss []T, source Uint64Source) {
+	for i := len(ss) - 1; i > 0; i-- {
+		j := uint64n(source, uint64(i+1))
+		ss[i], ss[j] = ss[j], ss[i]
+	}
+}
+
+// uint64n returns a uniformly distributed number in [0, n). It uses Lemire's
+// method, which avoids the bias of using a modulo and rarely needs a division.
+func uint64n(source Uint64Source, n uint64) uint64 {
+	hi, lo := bits.Mul64(source.Uint64(), n)
+	if lo < n {
+		threshold := -n % n
+		for lo < threshold {
+			hi, lo = bits.Mul64(source.Uint64(), n)
+		}
+	}
+
+	return hi
+}
@@ -0,0 +1,28 @@
+//go:build go1.22
+
+package pie_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShuffleWithRandV2(t *testing.T) {
+	ss := []int{1, 2, 3, 4, 5}
+
+	for _, source := range []pie.Uint64Source{
+		rand.NewPCG(1, 2),
+		rand.NewChaCha8([32]byte{}),
+		rand.New(rand.NewPCG(1, 2)),
+	} {
+		shuffled := pie.ShuffleWith(ss, source)
+		assert.ElementsMatch(t, ss, shuffled)
+	}
+
+	// The same seed gives the same order.
+	assert.Equal(t, pie.ShuffleWith(ss, rand.NewPCG(1, 2)),
+		pie.ShuffleWith(ss, rand.NewPCG(1, 2)))
+}
@@ -0,0 +1,35 @@
+package pie_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShuffleWith(t *testing.T) {
+	source := rand.NewSource(0).(rand.Source64)
+
+	assert.Equal(t, []int(nil), pie.ShuffleWith([]int(nil), source))
+	assert.Equal(t, []int{}, pie.ShuffleWith([]int{}, source))
+	assert.Equal(t, []int{1}, pie.ShuffleWith([]int{1}, source))
+	assert.Equal(t, []int{6, 9, 8, 1, 7, 10, 4, 3, 2, 5},
+		pie.ShuffleWith([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, rand.NewSource(0).(rand.Source64)))
+}
+
+func TestShuffleWithDistribution(t *testing.T) {
+	source := rand.NewSource(0).(rand.Source64)
+	counts := map[[3]int]int{}
+
+	for i := 0; i < 6000; i++ {
+		shuffled := pie.ShuffleWith([]int{1, 2, 3}, source)
+		counts[[3]int{shuffled[0], shuffled[1], shuffled[2]}]++
+	}
+
+	// Each of the 6 orders is expected 1000 times.
+	assert.Len(t, counts, 6)
+	for order, count := range counts {
+		assert.InDelta(t, 1000, count, 100, "%v", order)
+	}
+}
@@ -1,8 +1,10 @@
 package pie
 
 import (
-	"golang.org/x/exp/constraints"
 	"sort"
+
+	"golang.org/x/exp/constraints"
+	"golang.org/x/exp/slices"
 )
 
 // Sort works similar to sort.SliceType(). However, unlike sort.SliceType the
@@ -10,10 +12,10 @@ import (
 //
 // See Reverse() and AreSorted().
 func Sort[T constraints.Ordered](ss []T) []T {
-	// Avoid the allocation. If there is one element or less it is already
-	// sorted.
+	// There is nothing to sort with one element or less, but the result must
+	// still be a copy.
 	if len(ss) < 2 {
-		return ss
+		return slices.Clone(ss)
 	}
 
 	sorted := make([]T, len(ss))
@@ -2,15 +2,17 @@ package pie
 
 import (
 	"sort"
+
+	"golang.org/x/exp/slices"
 )
 
 // SortUsing works similar to sort.Slice. However, unlike sort.Slice the
 // slice returned will be reallocated as to not modify the input slice.
 func SortUsing[T any](ss []T, less func(a, b T) bool) []T {
-	// Avoid the allocation. If there is one element or less it is already
-	// sorted.
+	// There is nothing to sort with one element or less, but the result must
+	// still be a copy.
 	if len(ss) < 2 {
-		return ss
+		return slices.Clone(ss)
 	}
 
 	sorted := make([]T, len(ss))
@@ -0,0 +1,52 @@
+package pie
+
+import (
+	"math"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+var sparklineTicks = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline returns a single line that shows the shape of the elements, with
+// one block character per element scaled between the smallest and largest
+// element:
+//
+//	Sparkline([1, 2, 3, 4, 5, 6, 7, 8, 7, 1]) => "▁▂▃▄▅▆▇█▇▁"
+//
+// NaN and infinite values are shown as a space. If all of the elements are the
+// same, they are shown as the smallest block.
+func Sparkline[T constraints.Integer | constraints.Float](ss []T) string {
+	values := finiteFloat64s(ss)
+	if len(values) == 0 {
+		return strings.Repeat(" ", len(ss))
+	}
+
+	min, max := values[0], values[0]
+	for _, value := range values[1:] {
+		min = math.Min(min, value)
+		max = math.Max(max, value)
+	}
+
+	var sb strings.Builder
+	for _, s := range ss {
+		value := float64(s)
+
+		switch {
+		case math.IsNaN(value) || math.IsInf(value, 0):
+			sb.WriteRune(' ')
+
+		case min == max:
+			sb.WriteRune(sparklineTicks[0])
+
+		default:
+			// Halving everything first means the differences cannot overflow.
+			position := (value/2 - min/2) / (max/2 - min/2)
+			tick := int(math.Round(position * float64(len(sparklineTicks)-1)))
+			sb.WriteRune(sparklineTicks[tick])
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,32 @@
+package pie_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var sparklineTests = []struct {
+	ss       []float64
+	expected string
+}{
+	{nil, ""},
+	{[]float64{3, 3}, "▁▁"},
+	{[]float64{1, 2, 3, 4, 5, 6, 7, 8, 7, 1}, "▁▂▃▄▅▆▇█▇▁"},
+	{[]float64{0, math.NaN(), 10, math.Inf(1)}, "▁ █ "},
+	{[]float64{math.NaN()}, " "},
+}
+
+func TestSparkline(t *testing.T) {
+	for _, test := range sparklineTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.Sparkline(test.ss))
+		})
+	}
+}
+
+func TestSparklineExtremeValues(t *testing.T) {
+	assert.Equal(t, "▁▅█", pie.Sparkline([]float64{-math.MaxFloat64, 0, math.MaxFloat64}))
+}
@@ -0,0 +1,55 @@
+package pie
+
+import (
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// SpearmanRankCorrelation is the Spearman rank correlation coefficient of xs
+// and ys. It is the PearsonCorrelation of the ranks of the elements, so it
+// measures how close they are to always increasing (1) or decreasing (-1)
+// together, even when the relationship is not linear. Equal elements are given
+// the average of their ranks.
+//
+// ErrUnequalLengths is returned if xs and ys are not the same length. NaN is
+// returned if either slice has no variation (including when there are fewer
+// than two elements).
+func SpearmanRankCorrelation[T constraints.Integer | constraints.Float](xs, ys []T) (float64, error) {
+	if err := checkLengths(len(xs), len(ys)); err != nil {
+		return 0, err
+	}
+
+	return PearsonCorrelation(ranks(xs), ranks(ys))
+}
+
+// ranks returns the rank (starting at 1) of each element. Equal elements share
+// the average of their ranks.
+func ranks[T constraints.Integer | constraints.Float](ss []T) []float64 {
+	order := make([]int, len(ss))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(a, b int) bool {
+		return ss[order[a]] < ss[order[b]]
+	})
+
+	result := make([]float64, len(ss))
+	for start := 0; start < len(order); {
+		end := start + 1
+		for end < len(order) && ss[order[end]] == ss[order[start]] {
+			end++
+		}
+
+		// The average of the ranks start+1 to end.
+		rank := float64(start+end+1) / 2
+		for _, i := range order[start:end] {
+			result[i] = rank
+		}
+
+		start = end
+	}
+
+	return result
+}
@@ -0,0 +1,42 @@
+package pie_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var spearmanRankCorrelationTests = []struct {
+	xs, ys   []float64
+	expected float64
+}{
+	{nil, nil, math.NaN()},
+	{[]float64{1, 1}, []float64{1, 2}, math.NaN()},
+	// Not linear, but always increasing together.
+	{[]float64{1, 2, 3, 4, 5}, []float64{1, 4, 9, 16, 1000}, 1},
+	{[]float64{1, 2, 3, 4, 5}, []float64{5, 4, 3, 2, 1}, -1},
+	// Ties share the average rank.
+	{[]float64{1, 2, 2, 3}, []float64{1, 2, 3, 4}, 0.9486832980505138},
+	{[]float64{106, 100, 86, 101, 99, 103, 97, 113, 112, 110}, []float64{7, 27, 2, 50, 28, 29, 20, 12, 6, 17}, -0.17575757575757575},
+}
+
+func TestSpearmanRankCorrelation(t *testing.T) {
+	for _, test := range spearmanRankCorrelationTests {
+		t.Run("", func(t *testing.T) {
+			correlation, err := pie.SpearmanRankCorrelation(test.xs, test.ys)
+			assert.NoError(t, err)
+			if math.IsNaN(test.expected) {
+				assert.True(t, math.IsNaN(correlation))
+			} else {
+				assert.InDelta(t, test.expected, correlation, 1e-12)
+			}
+		})
+	}
+}
+
+func TestSpearmanRankCorrelationUnequalLengths(t *testing.T) {
+	_, err := pie.SpearmanRankCorrelation([]int{1}, nil)
+	assert.ErrorIs(t, err, pie.ErrUnequalLengths)
+}
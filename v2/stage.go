@@ -0,0 +1,34 @@
+package pie
+
+// Stage is a single named step of a Pipeline that transforms a slice of T into
+// a slice of U. See Then.
+type Stage[T, U any] struct {
+	Name string
+	Fn   func([]T) []U
+}
+
+// NewStage creates a Stage from any function that transforms a whole slice.
+// This allows any of the pie functions to be used as a stage:
+//
+//	pie.NewStage("sort", pie.Sort[int])
+//	pie.NewStage("top", func(ss []int) []int {
+//	    return pie.Top(ss, 10)
+//	})
+func NewStage[T, U any](name string, fn func([]T) []U) Stage[T, U] {
+	return Stage[T, U]{name, fn}
+}
+
+// MapStage creates a Stage that maps each element with fn. See Map.
+func MapStage[T, U any](name string, fn func(T) U) Stage[T, U] {
+	return NewStage(name, func(ss []T) []U {
+		return Map(ss, fn)
+	})
+}
+
+// FilterStage creates a Stage that only keeps the elements that return true
+// from condition. See Filter.
+func FilterStage[T any](name string, condition func(T) bool) Stage[T, T] {
+	return NewStage(name, func(ss []T) []T {
+		return Filter(ss, condition)
+	})
+}
@@ -15,17 +15,9 @@ func SubSlice[T any](ss []T, start int, end int) (subSlice []T) {
 		return
 	}
 
-	length := len(ss)
-	if start < length {
-		if end <= length {
-			subSlice = ss[start:end]
-		} else {
-			zeroArray := make([]T, end-length)
-			subSlice = append(ss[start:length], zeroArray[:]...)
-		}
-	} else {
-		zeroArray := make([]T, end-start)
-		subSlice = zeroArray[:]
+	subSlice = make([]T, end-start)
+	if start < len(ss) {
+		copy(subSlice, ss[start:])
 	}
 
 	return
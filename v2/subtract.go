@@ -0,0 +1,38 @@
+package pie
+
+import "golang.org/x/exp/constraints"
+
+// Subtract returns the elements of ss1 minus the element at the same position of
+// ss2:
+//
+//	Subtract([10, 20, 30], [1, 2, 3]) => [9, 18, 27]
+//
+// ErrUnequalLengths is returned if ss1 and ss2 are not the same length. nil is
+// returned if there are no elements.
+func Subtract[T constraints.Integer | constraints.Float](ss1, ss2 []T) ([]T, error) {
+	if err := checkLengths(len(ss1), len(ss2)); err != nil {
+		return nil, err
+	}
+
+	if len(ss1) == 0 {
+		return nil, nil
+	}
+
+	result := make([]T, len(ss1))
+
+	// Unrolled in the same way as Add.
+	i := 0
+	for ; i+4 <= len(result); i += 4 {
+		r, a, b := result[i:i+4:i+4], ss1[i:i+4:i+4], ss2[i:i+4:i+4]
+		r[0] = a[0] - b[0]
+		r[1] = a[1] - b[1]
+		r[2] = a[2] - b[2]
+		r[3] = a[3] - b[3]
+	}
+
+	for ; i < len(result); i++ {
+		result[i] = ss1[i] - ss2[i]
+	}
+
+	return result, nil
+}
@@ -0,0 +1,32 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var subtractTests = []struct {
+	ss1, ss2 []float64
+	expected []float64
+}{
+	{nil, nil, nil},
+	{[]float64{10, 20, 30}, []float64{1, 2, 3}, []float64{9, 18, 27}},
+	{[]float64{1, 1, 1, 1, 1, 1}, []float64{0.5, 1, 1.5, 2, 2.5, 3}, []float64{0.5, 0, -0.5, -1, -1.5, -2}},
+}
+
+func TestSubtract(t *testing.T) {
+	for _, test := range subtractTests {
+		t.Run("", func(t *testing.T) {
+			result, err := pie.Subtract(test.ss1, test.ss2)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestSubtractUnequalLengths(t *testing.T) {
+	_, err := pie.Subtract([]int{1}, []int{1, 2})
+	assert.ErrorIs(t, err, pie.ErrUnequalLengths)
+}
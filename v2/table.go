@@ -0,0 +1,290 @@
+package pie
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+)
+
+// TableStyle controls the borders drawn by Table.
+type TableStyle int
+
+const (
+	// TableASCII draws borders with +, - and | so that it displays correctly
+	// anywhere, such as in logs.
+	TableASCII TableStyle = iota
+
+	// TableMarkdown produces a GitHub flavored Markdown table. Any | in a cell
+	// is escaped.
+	TableMarkdown
+
+	// TableBox draws borders with Unicode box drawing characters.
+	TableBox
+)
+
+// TableAlign is the alignment of a TableColumn.
+type TableAlign int
+
+const (
+	// AlignAuto aligns numbers to the right and everything else to the left.
+	AlignAuto TableAlign = iota
+	AlignLeft
+	AlignRight
+)
+
+// TableColumn is a single column for Table. Value returns the cell for each
+// element, which is formatted with fmt.Sprint. A nil pointer is shown as an
+// empty cell, any other pointer is shown as the value it points to.
+//
+// With AlignAuto, the column is aligned to the right if every value (ignoring
+// nils) is an integer or float, such as the types allowed by OfNumeric.
+//
+// If MaxWidth is greater than zero, longer cells (including the header) are
+// truncated and end with "…".
+type TableColumn[T any] struct {
+	Name     string
+	Value    func(T) any
+	Align    TableAlign
+	MaxWidth int
+}
+
+// Table writes ss to w as a table with a header row, aligning the columns so
+// that they are easy to read in a terminal:
+//
+//	+----+------+
+//	| ID | Name |
+//	+----+------+
+//	|  1 | Bob  |
+//	| 23 | Jane |
+//	+----+------+
+//
+// If no columns are provided and T is a struct (or a pointer to a struct),
+// there will be a column for each exported field. Otherwise, there will be a
+// single column called "Value".
+//
+// Widths are counted in runes, so characters that are displayed wider (or
+// narrower) than others may not line up. New lines in cells are replaced with
+// spaces.
+func Table[T any](w io.Writer, ss []T, style TableStyle, columns ...TableColumn[T]) error {
+	if len(columns) == 0 {
+		columns = tableFields[T]()
+	}
+
+	cell := func(text string, maxWidth int) string {
+		text = tableCell(text, maxWidth)
+		if style == TableMarkdown {
+			text = strings.ReplaceAll(text, "|", `\|`)
+		}
+
+		return text
+	}
+
+	header := make([]string, len(columns))
+	widths := make([]int, len(columns))
+	right := make([]bool, len(columns))
+	for i, column := range columns {
+		header[i] = cell(column.Name, column.MaxWidth)
+		widths[i] = utf8.RuneCountInString(header[i])
+		right[i] = column.Align == AlignRight
+	}
+
+	numeric := make([]bool, len(columns))
+	for i := range numeric {
+		numeric[i] = columns[i].Align == AlignAuto && len(ss) > 0
+	}
+
+	rows := make([][]string, len(ss))
+	for row, s := range ss {
+		rows[row] = make([]string, len(columns))
+		for i, column := range columns {
+			value := tableValue(column.Value(s))
+			if value != nil && !tableNumeric(value) {
+				numeric[i] = false
+			}
+
+			text := ""
+			if value != nil {
+				text = fmt.Sprint(value)
+			}
+
+			rows[row][i] = cell(text, column.MaxWidth)
+			if width := utf8.RuneCountInString(rows[row][i]); width > widths[i] {
+				widths[i] = width
+			}
+		}
+	}
+
+	for i := range right {
+		right[i] = right[i] || numeric[i]
+	}
+
+	t := table{bufio.NewWriter(w), widths, right}
+	switch style {
+	case TableMarkdown:
+		t.row("|", "|", "|", header)
+		t.markdownSeparator()
+		for _, row := range rows {
+			t.row("|", "|", "|", row)
+		}
+
+	case TableBox:
+		t.line("┌", "┬", "┐", "─")
+		t.row("│", "│", "│", header)
+		t.line("├", "┼", "┤", "─")
+		for _, row := range rows {
+			t.row("│", "│", "│", row)
+		}
+		t.line("└", "┴", "┘", "─")
+
+	default:
+		t.line("+", "+", "+", "-")
+		t.row("|", "|", "|", header)
+		t.line("+", "+", "+", "-")
+		for _, row := range rows {
+			t.row("|", "|", "|", row)
+		}
+		t.line("+", "+", "+", "-")
+	}
+
+	return t.w.Flush()
+}
+
+// tableFields returns a column for each exported field if T is a struct (or a
+// pointer to a struct), otherwise a single column for the whole value.
+func tableFields[T any]() []TableColumn[T] {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	if typ.Kind() != reflect.Struct {
+		return []TableColumn[T]{{
+			Name:  "Value",
+			Value: func(s T) any { return s },
+		}}
+	}
+
+	var columns []TableColumn[T]
+	for _, field := range reflect.VisibleFields(typ) {
+		if !field.IsExported() || field.Anonymous {
+			continue
+		}
+
+		index := field.Index
+		columns = append(columns, TableColumn[T]{
+			Name: field.Name,
+			Value: func(s T) any {
+				v := reflect.ValueOf(&s).Elem()
+				for _, i := range index {
+					if v.Kind() == reflect.Ptr {
+						if v.IsNil() {
+							return nil
+						}
+
+						v = v.Elem()
+					}
+
+					v = v.Field(i)
+				}
+
+				return v.Interface()
+			},
+		})
+	}
+
+	return columns
+}
+
+// tableValue follows pointers, returning nil for a nil pointer (or nil
+// interface).
+func tableValue(value any) any {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+
+		v = v.Elem()
+	}
+
+	if !v.IsValid() {
+		return nil
+	}
+
+	return v.Interface()
+}
+
+func tableNumeric(value any) bool {
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Uintptr, reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
+
+// tableCell removes new lines and truncates the text to maxWidth runes (if
+// maxWidth is greater than zero).
+func tableCell(text string, maxWidth int) string {
+	text = strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ").Replace(text)
+
+	if maxWidth > 0 && utf8.RuneCountInString(text) > maxWidth {
+		runes := []rune(text)
+		text = string(runes[:maxWidth-1]) + "…"
+	}
+
+	return text
+}
+
+// table writes the lines of a table. Errors are returned by Flush.
+type table struct {
+	w      *bufio.Writer
+	widths []int
+	right  []bool
+}
+
+func (t table) line(left, middle, end, fill string) {
+	t.w.WriteString(left)
+	for i, width := range t.widths {
+		if i > 0 {
+			t.w.WriteString(middle)
+		}
+
+		t.w.WriteString(strings.Repeat(fill, width+2))
+	}
+	t.w.WriteString(end + "\n")
+}
+
+func (t table) markdownSeparator() {
+	t.w.WriteString("|")
+	for i, width := range t.widths {
+		if t.right[i] {
+			t.w.WriteString(strings.Repeat("-", width+1) + ":|")
+		} else {
+			t.w.WriteString(strings.Repeat("-", width+2) + "|")
+		}
+	}
+	t.w.WriteString("\n")
+}
+
+func (t table) row(left, middle, end string, cells []string) {
+	t.w.WriteString(left)
+	for i, cell := range cells {
+		if i > 0 {
+			t.w.WriteString(middle)
+		}
+
+		padding := strings.Repeat(" ", t.widths[i]-utf8.RuneCountInString(cell))
+		if t.right[i] {
+			t.w.WriteString(" " + padding + cell + " ")
+		} else {
+			t.w.WriteString(" " + cell + padding + " ")
+		}
+	}
+	t.w.WriteString(end + "\n")
+}
@@ -0,0 +1,135 @@
+package pie_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type tableUser struct {
+	ID    int
+	Name  string
+	Score *float64
+	email string
+}
+
+var tableUsers = func() []tableUser {
+	score := 12.5
+	return []tableUser{
+		{1, "Bob", &score, "bob@example.com"},
+		{23, "Jane | Doe", nil, ""},
+	}
+}()
+
+var tableTests = []struct {
+	style    pie.TableStyle
+	expected string
+}{
+	{
+		pie.TableASCII,
+		`+----+------------+-------+
+| ID | Name       | Score |
++----+------------+-------+
+|  1 | Bob        |  12.5 |
+| 23 | Jane | Doe |       |
++----+------------+-------+
+`,
+	},
+	{
+		pie.TableMarkdown,
+		`| ID | Name        | Score |
+|---:|-------------|------:|
+|  1 | Bob         |  12.5 |
+| 23 | Jane \| Doe |       |
+`,
+	},
+	{
+		pie.TableBox,
+		`┌────┬────────────┬───────┐
+│ ID │ Name       │ Score │
+├────┼────────────┼───────┤
+│  1 │ Bob        │  12.5 │
+│ 23 │ Jane | Doe │       │
+└────┴────────────┴───────┘
+`,
+	},
+}
+
+func TestTable(t *testing.T) {
+	for _, test := range tableTests {
+		t.Run("", func(t *testing.T) {
+			var buf bytes.Buffer
+			assert.NoError(t, pie.Table(&buf, tableUsers, test.style))
+			assert.Equal(t, test.expected, buf.String())
+		})
+	}
+}
+
+func TestTableColumns(t *testing.T) {
+	var buf bytes.Buffer
+	err := pie.Table(&buf, []*tableUser{&tableUsers[0], nil, &tableUsers[1]}, pie.TableASCII,
+		pie.TableColumn[*tableUser]{
+			Name:     "Name",
+			MaxWidth: 6,
+			Value: func(u *tableUser) any {
+				if u == nil {
+					return nil
+				}
+
+				return u.Name
+			},
+		},
+		pie.TableColumn[*tableUser]{
+			Name:  "Identifier",
+			Align: pie.AlignLeft,
+			Value: func(u *tableUser) any {
+				if u == nil {
+					return nil
+				}
+
+				return u.ID
+			},
+		},
+		pie.TableColumn[*tableUser]{
+			Name:  "Note",
+			Align: pie.AlignRight,
+			Value: func(*tableUser) any { return "a\nb" },
+		},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, `+--------+------------+------+
+| Name   | Identifier | Note |
++--------+------------+------+
+| Bob    | 1          |  a b |
+|        |            |  a b |
+| Jane … | 23         |  a b |
++--------+------------+------+
+`, buf.String())
+}
+
+func TestTableValues(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, pie.Table(&buf, []string{"a", "héllo"}, pie.TableMarkdown))
+	assert.Equal(t, `| Value |
+|-------|
+| a     |
+| héllo |
+`, buf.String())
+
+	buf.Reset()
+	assert.NoError(t, pie.Table(&buf, []float64{}, pie.TableMarkdown))
+	assert.Equal(t, "| Value |\n|-------|\n", buf.String())
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("failed")
+}
+
+func TestTableError(t *testing.T) {
+	assert.EqualError(t, pie.Table(failingWriter{}, []int{1}, pie.TableASCII), "failed")
+}
@@ -0,0 +1,42 @@
+package pie
+
+import "time"
+
+// Then returns a new Pipeline that runs stage on the result of p. The stage
+// may change the element type, which is not possible with the chaining
+// methods of OfSlice.
+//
+// p is not modified, so the same pipeline may be extended more than once.
+func Then[T, U, V any](p Pipeline[T, U], stage Stage[U, V]) Pipeline[T, V] {
+	index := len(p.stages)
+	previous := p.run
+
+	return Pipeline[T, V]{
+		name: p.name,
+
+		// The full slice expression forces a copy on append so that pipelines
+		// that branch from p do not share the underlying array.
+		stages: append(p.stages[:index:index], stage.Name),
+
+		hook: p.hook,
+		run: func(ss []T, hook func(StageMetrics)) []V {
+			in := previous(ss, hook)
+			if hook == nil {
+				return stage.Fn(in)
+			}
+
+			start := time.Now()
+			out := stage.Fn(in)
+			hook(StageMetrics{
+				Pipeline: p.name,
+				Stage:    stage.Name,
+				Index:    index,
+				In:       len(in),
+				Out:      len(out),
+				Duration: time.Since(start),
+			})
+
+			return out
+		},
+	}
+}
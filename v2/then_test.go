@@ -0,0 +1,33 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThen(t *testing.T) {
+	base := pie.Then(pie.NewPipeline[int]("base"), pie.NewStage("sort", pie.Sort[int]))
+
+	// Branching from the same pipeline must not affect each other.
+	top := pie.Then(base, pie.NewStage("top", func(ss []int) []int {
+		return pie.Top(ss, 2)
+	}))
+	bottom := pie.Then(base, pie.NewStage("bottom", func(ss []int) []int {
+		return pie.Bottom(ss, 2)
+	}))
+
+	assert.Equal(t, []string{"sort"}, base.Stages())
+	assert.Equal(t, []string{"sort", "top"}, top.Stages())
+	assert.Equal(t, []string{"sort", "bottom"}, bottom.Stages())
+
+	ss := []int{5, 3, 1, 4, 2}
+	assert.Equal(t, []int{1, 2}, top.Run(ss))
+	assert.Equal(t, []int{5, 4}, bottom.Run(ss))
+
+	lengths := pie.Then(top, pie.MapStage("double", func(i int) float64 {
+		return float64(i) * 2.5
+	}))
+	assert.Equal(t, []float64{2.5, 5}, lengths.Run(ss))
+}
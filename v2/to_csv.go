@@ -0,0 +1,63 @@
+package pie
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// ToCSV writes ss to w as CSV with a header row, followed by one row for each
+// element. The header is always written, even if ss is empty.
+//
+// If no columns are provided, the columns from CSVFields are used, so T must
+// be a struct or a pointer to a struct. Otherwise, the columns are written in
+// the order they are provided:
+//
+//	pie.ToCSV(w, users,
+//		pie.CSVColumn[User]{Name: "id", Value: func(u User) string { return u.ID }},
+//		pie.CSVColumn[User]{Name: "email", Value: func(u User) string { return u.Email }},
+//	)
+//
+// A *CSVError is returned if a field from CSVFields cannot be formatted.
+func ToCSV[T any](w io.Writer, ss []T, columns ...CSVColumn[T]) error {
+	if len(columns) == 0 {
+		var err error
+		columns, err = CSVFields[T]()
+		if err != nil {
+			return err
+		}
+	}
+
+	writer := csv.NewWriter(w)
+	record := make([]string, len(columns))
+
+	for i, column := range columns {
+		record[i] = column.Name
+	}
+
+	if err := writer.Write(record); err != nil {
+		return err
+	}
+
+	for row, s := range ss {
+		for i, column := range columns {
+			if column.format == nil {
+				record[i] = column.Value(s)
+				continue
+			}
+
+			var err error
+			record[i], err = column.format(s)
+			if err != nil {
+				return &CSVError{Row: row + 2, Column: column.Name, Err: err}
+			}
+		}
+
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
@@ -0,0 +1,83 @@
+package pie_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type failingText struct{}
+
+func (failingText) MarshalText() ([]byte, error) {
+	return nil, errors.New("failed")
+}
+
+func (*failingText) UnmarshalText([]byte) error {
+	return nil
+}
+
+func TestToCSV(t *testing.T) {
+	note := "a, \"quoted\"\nnote"
+	records := []csvRecord{
+		{ID: 1, Name: "Bob", Score: 1.5, Active: true, Secret: "x"},
+		{ID: 2, Name: "Jane", Score: -2, CSVAudit: &CSVAudit{
+			Created: time.Date(2022, 4, 1, 12, 30, 0, 0, time.UTC),
+			Note:    &note,
+		}},
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, pie.ToCSV(&buf, records))
+	assert.Equal(t, `id,name,score,Active,created,note
+1,Bob,1.5,true,,
+2,Jane,-2,false,2022-04-01T12:30:00Z,"a, ""quoted""
+note"
+`, buf.String())
+
+	roundTrip, err := pie.FromCSV[csvRecord](&buf)
+	assert.NoError(t, err)
+	records[0].Secret = ""
+	assert.Equal(t, records, roundTrip)
+}
+
+func TestToCSVColumns(t *testing.T) {
+	columns, err := pie.CSVFields[*csvRecord]("name", "id")
+	assert.NoError(t, err)
+
+	columns = append(columns, pie.CSVColumn[*csvRecord]{
+		Name: "upper",
+		Value: func(r *csvRecord) string {
+			return strings.ToUpper(r.Name)
+		},
+	})
+
+	var buf bytes.Buffer
+	assert.NoError(t, pie.ToCSV(&buf, []*csvRecord{{ID: 1, Name: "Bob"}}, columns...))
+	assert.Equal(t, "name,id,upper\nBob,1,BOB\n", buf.String())
+}
+
+func TestToCSVEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, pie.ToCSV(&buf, []csvRecord(nil), pie.CSVColumn[csvRecord]{Name: "a"}))
+	assert.Equal(t, "a\n", buf.String())
+}
+
+func TestToCSVErrors(t *testing.T) {
+	var buf bytes.Buffer
+	assert.EqualError(t, pie.ToCSV(&buf, []int{1}), "int is not a struct")
+
+	type row struct {
+		Value failingText
+	}
+
+	err := pie.ToCSV(&buf, []row{{}})
+	var csvErr *pie.CSVError
+	assert.ErrorAs(t, err, &csvErr)
+	assert.Equal(t, &pie.CSVError{Row: 2, Column: "Value", Err: errors.New("failed")}, csvErr)
+	assert.EqualError(t, err, `row 2, column "Value": failed`)
+}
@@ -0,0 +1,43 @@
+package pie
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// TrimmedMean is the average of the elements after removing the smallest and
+// largest fraction of them, which makes it less sensitive to outliers than
+// Average. For example, a fraction of 0.1 ignores the lowest 10% and highest
+// 10% of the elements:
+//
+//	TrimmedMean([1, 2, 3, 4, 5, 6, 7, 8, 9, 1000], 0.1) => 5.5
+//
+// The number of elements removed from each end is rounded down. Zero is
+// returned if there are no elements. It will panic if fraction is not in the
+// range [0, 0.5).
+func TrimmedMean[T constraints.Integer | constraints.Float](ss []T, fraction float64) float64 {
+	if !(fraction >= 0 && fraction < 0.5) {
+		panic("fraction should be in the range [0, 0.5)")
+	}
+
+	n := len(ss)
+	if n == 0 {
+		return 0
+	}
+
+	work := make([]float64, n)
+	for i, s := range ss {
+		work[i] = float64(s)
+	}
+
+	// After the two selections, work[k:n-k] contains the elements to keep,
+	// although not in order.
+	k := int(math.Floor(float64(n) * fraction))
+	if k > 0 {
+		quickselect(work, k, k+1)
+		quickselect(work[k:], n-2*k-1, n-2*k)
+	}
+
+	return mean(work[k : n-k])
+}
@@ -0,0 +1,57 @@
+package pie_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var trimmedMeanTests = []struct {
+	ss       []int
+	fraction float64
+	expected float64
+}{
+	{nil, 0.1, 0},
+	{[]int{7}, 0.4, 7},
+	{[]int{1, 2, 3}, 0, 2},
+	{[]int{1000, 3, 1, 2}, 0.25, 2.5},
+	{[]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 1000}, 0.1, 5.5},
+	{[]int{1000, 9, 8, 7, 6, 5, 4, 3, 2, 1}, 0.19, 5.5},
+	{[]int{-500, 1000, 5, 5, 5, 4, 6}, 0.3, 5},
+}
+
+func TestTrimmedMean(t *testing.T) {
+	for _, test := range trimmedMeanTests {
+		t.Run("", func(t *testing.T) {
+			ss := append([]int(nil), test.ss...)
+			assert.Equal(t, test.expected, pie.TrimmedMean(ss, test.fraction))
+			assert.Equal(t, test.ss, ss)
+		})
+	}
+}
+
+func TestTrimmedMeanPanics(t *testing.T) {
+	for _, fraction := range []float64{-0.1, 0.5, 1} {
+		assert.PanicsWithValue(t, "fraction should be in the range [0, 0.5)", func() {
+			pie.TrimmedMean([]int{1}, fraction)
+		})
+	}
+}
+
+func TestTrimmedMeanMatchesSorting(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	for i := 0; i < 1000; i++ {
+		ss := make([]int, r.Intn(30)+1)
+		for j := range ss {
+			ss[j] = r.Intn(10)
+		}
+
+		fraction := r.Float64() / 2
+		sorted := pie.Sort(ss)
+		k := int(float64(len(ss)) * fraction)
+
+		assert.InDelta(t, pie.Average(sorted[k:len(ss)-k]), pie.TrimmedMean(ss, fraction), 1e-9)
+	}
+}
@@ -1,5 +1,7 @@
 package pie
 
+import "golang.org/x/exp/slices"
+
 // Unique returns a new slice with all of the unique values.
 //
 // The items will be returned in a randomized order, even with the same input.
@@ -9,12 +11,12 @@ package pie
 //
 // A slice with zero elements is considered to be unique.
 //
-// See AreUnique().
+// See AreUnique(). Use Dedup to only remove consecutive duplicates.
 func Unique[T comparable](ss []T) []T {
-	// Avoid the allocation. If there is one element or less it is already
-	// unique.
+	// There is nothing to remove with one element or less, but the result must
+	// still be a copy.
 	if len(ss) < 2 {
-		return ss
+		return slices.Clone(ss)
 	}
 
 	values := map[T]struct{}{}
@@ -1,12 +1,14 @@
 package pie
 
+import "golang.org/x/exp/slices"
+
 // UniqueStable works similar to Unique. However, unlike Unique
 // the slice returned will be in previous relative order
 func UniqueStable[T comparable](ss []T) []T {
-	// Avoid the allocation. If there is one element or less it is already
-	// unique.
+	// There is nothing to remove with one element or less, but the result must
+	// still be a copy.
 	if len(ss) < 2 {
-		return ss
+		return slices.Clone(ss)
 	}
 
 	seen := map[T]struct{}{}
@@ -0,0 +1,14 @@
+package pie
+
+// Unzip is the opposite of Zip. It returns the A and B values of each pair as
+// two new slices with the same length as zs.
+func Unzip[T1, T2 any](zs []Zipped[T1, T2]) ([]T1, []T2) {
+	ss1 := make([]T1, len(zs))
+	ss2 := make([]T2, len(zs))
+	for i, z := range zs {
+		ss1[i] = z.A
+		ss2[i] = z.B
+	}
+
+	return ss1, ss2
+}
@@ -0,0 +1,26 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnzip(t *testing.T) {
+	for _, test := range zipTests {
+		t.Run("", func(t *testing.T) {
+			ss1, ss2 := pie.Unzip(pie.Zip(test.ss1, test.ss2))
+
+			n := len(test.expectedShort)
+			assert.Equal(t, test.ss1[:n], ss1)
+			assert.Equal(t, test.ss2[:n], ss2)
+		})
+	}
+}
+
+func TestUnzipEmpty(t *testing.T) {
+	ss1, ss2 := pie.Unzip([]pie.Zipped[int, string](nil))
+	assert.Equal(t, []int{}, ss1)
+	assert.Equal(t, []string{}, ss2)
+}
@@ -0,0 +1,166 @@
+package pie_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+)
+
+// The vector benchmarks compare the unrolled functions with the simple loop
+// that they replace.
+
+var (
+	benchmarkFloats1 = pie.SequenceUsing([]float64{}, func(i int) float64 { return float64(i) / 3 }, 1000)
+	benchmarkFloats2 = pie.SequenceUsing([]float64{}, func(i int) float64 { return float64(i%7) + 1 }, 1000)
+
+	// Prevent the compiler from optimizing away the results.
+	sinkFloats []float64
+	sinkFloat  float64
+)
+
+func BenchmarkVectorAdd(b *testing.B) {
+	b.Run("Naive", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			result := make([]float64, len(benchmarkFloats1))
+			for j := range result {
+				result[j] = benchmarkFloats1[j] + benchmarkFloats2[j]
+			}
+			sinkFloats = result
+		}
+	})
+
+	b.Run("Add", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sinkFloats, _ = pie.Add(benchmarkFloats1, benchmarkFloats2)
+		}
+	})
+}
+
+func BenchmarkVectorSubtract(b *testing.B) {
+	b.Run("Naive", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			result := make([]float64, len(benchmarkFloats1))
+			for j := range result {
+				result[j] = benchmarkFloats1[j] - benchmarkFloats2[j]
+			}
+			sinkFloats = result
+		}
+	})
+
+	b.Run("Subtract", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sinkFloats, _ = pie.Subtract(benchmarkFloats1, benchmarkFloats2)
+		}
+	})
+}
+
+func BenchmarkVectorMultiply(b *testing.B) {
+	b.Run("Naive", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			result := make([]float64, len(benchmarkFloats1))
+			for j := range result {
+				result[j] = benchmarkFloats1[j] * benchmarkFloats2[j]
+			}
+			sinkFloats = result
+		}
+	})
+
+	b.Run("Multiply", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sinkFloats, _ = pie.Multiply(benchmarkFloats1, benchmarkFloats2)
+		}
+	})
+}
+
+func BenchmarkVectorDivide(b *testing.B) {
+	b.Run("Naive", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			result := make([]float64, len(benchmarkFloats1))
+			for j := range result {
+				result[j] = benchmarkFloats1[j] / benchmarkFloats2[j]
+			}
+			sinkFloats = result
+		}
+	})
+
+	b.Run("Divide", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sinkFloats, _ = pie.Divide(benchmarkFloats1, benchmarkFloats2)
+		}
+	})
+}
+
+func BenchmarkVectorScale(b *testing.B) {
+	b.Run("Naive", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			result := make([]float64, len(benchmarkFloats1))
+			for j, f := range benchmarkFloats1 {
+				result[j] = f * 1.5
+			}
+			sinkFloats = result
+		}
+	})
+
+	b.Run("Scale", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sinkFloats = pie.Scale(benchmarkFloats1, 1.5)
+		}
+	})
+}
+
+func BenchmarkVectorDot(b *testing.B) {
+	b.Run("Naive", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var sum float64
+			for j, f := range benchmarkFloats1 {
+				sum += f * benchmarkFloats2[j]
+			}
+			sinkFloat = sum
+		}
+	})
+
+	b.Run("Dot", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sinkFloat, _ = pie.Dot(benchmarkFloats1, benchmarkFloats2)
+		}
+	})
+}
+
+func BenchmarkVectorNorm(b *testing.B) {
+	b.Run("Naive", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var sum float64
+			for _, f := range benchmarkFloats1 {
+				sum += f * f
+			}
+			sinkFloat = math.Sqrt(sum)
+		}
+	})
+
+	b.Run("Norm", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sinkFloat = pie.Norm(benchmarkFloats1)
+		}
+	})
+}
+
+func BenchmarkVectorCumulativeDot(b *testing.B) {
+	b.Run("Naive", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			result := make([]float64, len(benchmarkFloats1))
+			var sum float64
+			for j, f := range benchmarkFloats1 {
+				sum += f * benchmarkFloats2[j]
+				result[j] = sum
+			}
+			sinkFloats = result
+		}
+	})
+
+	b.Run("CumulativeDot", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sinkFloats, _ = pie.CumulativeDot(benchmarkFloats1, benchmarkFloats2)
+		}
+	})
+}
@@ -0,0 +1,29 @@
+package pie
+
+import "golang.org/x/exp/constraints"
+
+// WeightedAverage is the average of the elements where each element counts
+// weights[i] times, that is sum(ss[i] * weights[i]) / sum(weights):
+//
+//	WeightedAverage([1, 2, 3], [3, 1, 0]) => 1.25
+//
+// ErrUnequalLengths is returned if ss and weights are not the same length.
+// Zero is returned if the weights add up to zero, including when there are no
+// elements.
+func WeightedAverage[T constraints.Integer | constraints.Float](ss []T, weights []float64) (float64, error) {
+	if err := checkLengths(len(ss), len(weights)); err != nil {
+		return 0, err
+	}
+
+	var sum, totalWeight float64
+	for i, s := range ss {
+		sum += float64(s) * weights[i]
+		totalWeight += weights[i]
+	}
+
+	if totalWeight == 0 {
+		return 0, nil
+	}
+
+	return sum / totalWeight, nil
+}
@@ -0,0 +1,35 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var weightedAverageTests = []struct {
+	ss       []int
+	weights  []float64
+	expected float64
+}{
+	{nil, nil, 0},
+	{[]int{1, 2}, []float64{0, 0}, 0},
+	{[]int{1, 2, 3}, []float64{1, 1, 1}, 2},
+	{[]int{1, 2, 3}, []float64{3, 1, 0}, 1.25},
+	{[]int{10, 20}, []float64{0.25, 0.75}, 17.5},
+}
+
+func TestWeightedAverage(t *testing.T) {
+	for _, test := range weightedAverageTests {
+		t.Run("", func(t *testing.T) {
+			average, err := pie.WeightedAverage(test.ss, test.weights)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, average)
+		})
+	}
+}
+
+func TestWeightedAverageUnequalLengths(t *testing.T) {
+	_, err := pie.WeightedAverage([]int{1, 2}, []float64{1})
+	assert.ErrorIs(t, err, pie.ErrUnequalLengths)
+}
@@ -0,0 +1,44 @@
+package pie
+
+import (
+	"math/rand"
+)
+
+// WeightedChoice returns a random element by your rand.Source, where the chance
+// of each element being chosen is proportional to its weight. Elements with a
+// weight of zero or less are never chosen.
+//
+// If there are no elements with a positive weight, the zero value and false
+// are returned.
+func WeightedChoice[T any](ss []T, weight func(T) float64, source rand.Source) (T, bool) {
+	weights := make([]float64, len(ss))
+	var total float64
+	last := -1
+	for i, s := range ss {
+		weights[i] = weight(s)
+		if weights[i] > 0 {
+			total += weights[i]
+			last = i
+		}
+	}
+
+	if last < 0 {
+		var zeroValue T
+		return zeroValue, false
+	}
+
+	r := rand.New(source).Float64() * total
+	for i, w := range weights[:last] {
+		if w > 0 {
+			if r < w {
+				return ss[i], true
+			}
+
+			r -= w
+		}
+	}
+
+	// Rounding errors can leave a small remainder, which belongs to the last
+	// element with a positive weight.
+	return ss[last], true
+}
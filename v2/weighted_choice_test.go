@@ -0,0 +1,68 @@
+package pie_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var letterWeights = map[string]float64{"a": 1, "b": 0, "c": 5, "d": 2, "e": -1}
+
+func letterWeight(s string) float64 {
+	return letterWeights[s]
+}
+
+var weightedChoiceTests = []struct {
+	ss       []string
+	expected string
+	ok       bool
+}{
+	{nil, "", false},
+	{[]string{"b", "e"}, "", false},
+	{[]string{"b", "a", "e"}, "a", true},
+	{[]string{"a", "b", "c", "d", "e"}, "d", true},
+}
+
+func TestWeightedChoice(t *testing.T) {
+	for _, test := range weightedChoiceTests {
+		t.Run("", func(t *testing.T) {
+			actual, ok := pie.WeightedChoice(test.ss, letterWeight, rand.NewSource(0))
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, test.ok, ok)
+		})
+	}
+}
+
+func TestWeightedChoiceDistribution(t *testing.T) {
+	ss := []string{"a", "b", "c", "d", "e"}
+	source := rand.NewSource(0)
+	counts := map[string]int{}
+
+	for i := 0; i < 8000; i++ {
+		choice, _ := pie.WeightedChoice(ss, letterWeight, source)
+		counts[choice]++
+	}
+
+	// The total weight is 8, so the counts are expected to be 1000 times the
+	// weight.
+	assert.InDelta(t, 1000, counts["a"], 150)
+	assert.Zero(t, counts["b"])
+	assert.InDelta(t, 5000, counts["c"], 150)
+	assert.InDelta(t, 2000, counts["d"], 150)
+	assert.Zero(t, counts["e"])
+}
+
+func TestWeightedChoiceCallsWeightOnce(t *testing.T) {
+	ss := []string{"a", "b", "c", "d", "e"}
+	calls := map[string]int{}
+	weight := func(s string) float64 {
+		calls[s]++
+		return letterWeight(s)
+	}
+
+	pie.WeightedChoice(ss, weight, rand.NewSource(0))
+
+	assert.Equal(t, map[string]int{"a": 1, "b": 1, "c": 1, "d": 1, "e": 1}, calls)
+}
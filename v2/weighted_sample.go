@@ -0,0 +1,63 @@
+package pie
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// WeightedSample returns k different elements chosen at random by your
+// rand.Source, where the chance of each element being chosen is proportional
+// to its weight. Elements with a weight of zero or less are never chosen, so
+// fewer than k elements are returned if there are not enough elements with a
+// positive weight.
+//
+// Like Sample, each element can only be chosen once and the chosen elements are
+// returned in the same order that they appear in ss. If k is less than 1, nil
+// is returned.
+//
+// WeightedSample uses the algorithm by Efraimidis and Spirakis, which gives
+// each element a random key based on its weight and chooses the k largest
+// keys.
+func WeightedSample[T any](ss []T, k int, weight func(T) float64, source rand.Source) []T {
+	if k < 1 {
+		return nil
+	}
+
+	type keyed struct {
+		index int
+		key   float64
+	}
+
+	rnd := rand.New(source)
+	var keys []keyed
+	for i, s := range ss {
+		if w := weight(s); w > 0 {
+			// This is the log of u^(1/w), which has the same order but does
+			// not underflow for small weights.
+			keys = append(keys, keyed{i, math.Log(1-rnd.Float64()) / w})
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	if k < len(keys) {
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i].key > keys[j].key
+		})
+		keys = keys[:k]
+
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i].index < keys[j].index
+		})
+	}
+
+	sample := make([]T, len(keys))
+	for i, key := range keys {
+		sample[i] = ss[key.index]
+	}
+
+	return sample
+}
@@ -0,0 +1,46 @@
+package pie_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var weightedSampleTests = []struct {
+	ss       []string
+	k        int
+	expected []string
+}{
+	{nil, 2, nil},
+	{[]string{"a", "c"}, 0, nil},
+	{[]string{"b", "e"}, 2, nil},
+	{[]string{"a", "b", "c", "d", "e"}, 5, []string{"a", "c", "d"}},
+	{[]string{"a", "b", "c", "d", "e"}, 2, []string{"c", "d"}},
+}
+
+func TestWeightedSample(t *testing.T) {
+	for _, test := range weightedSampleTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected,
+				pie.WeightedSample(test.ss, test.k, letterWeight, rand.NewSource(0)))
+		})
+	}
+}
+
+func TestWeightedSampleDistribution(t *testing.T) {
+	ss := []string{"a", "b", "c", "d", "e"}
+	source := rand.NewSource(0)
+	counts := map[string]int{}
+
+	for i := 0; i < 4000; i++ {
+		sample := pie.WeightedSample(ss, 1, letterWeight, source)
+		counts[sample[0]]++
+	}
+
+	// With a single element it is the same as WeightedChoice.
+	assert.InDelta(t, 500, counts["a"], 100)
+	assert.InDelta(t, 2500, counts["c"], 100)
+	assert.InDelta(t, 1000, counts["d"], 100)
+}
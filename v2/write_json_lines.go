@@ -0,0 +1,25 @@
+package pie
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// WriteJSONLines writes each element of ss to w as a single line of JSON (also
+// known as JSON Lines or NDJSON). Elements are encoded and written one at a
+// time, so the whole output is never held in memory.
+//
+// Nothing is written for an empty slice. If an element cannot be encoded, the
+// elements before it will have already been written and the error includes the
+// index of the element.
+func WriteJSONLines[T any](w io.Writer, ss []T) error {
+	encoder := json.NewEncoder(w)
+	for i, s := range ss {
+		if err := encoder.Encode(s); err != nil {
+			return fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
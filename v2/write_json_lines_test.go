@@ -0,0 +1,43 @@
+package pie_test
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var writeJSONLinesTests = []struct {
+	ss       []jsonPerson
+	expected string
+}{
+	{nil, ""},
+	{[]jsonPerson{{"Bob", 23}}, "{\"name\":\"Bob\",\"age\":23}\n"},
+	{
+		[]jsonPerson{{"Bob", 23}, {Name: "Jane"}},
+		"{\"name\":\"Bob\",\"age\":23}\n{\"name\":\"Jane\"}\n",
+	},
+}
+
+func TestWriteJSONLines(t *testing.T) {
+	for _, test := range writeJSONLinesTests {
+		t.Run("", func(t *testing.T) {
+			var buf bytes.Buffer
+			assert.NoError(t, pie.WriteJSONLines(&buf, test.ss))
+			assert.Equal(t, test.expected, buf.String())
+
+			ss, err := pie.ReadJSONLines[jsonPerson](&buf)
+			assert.NoError(t, err)
+			assert.Equal(t, test.ss, ss)
+		})
+	}
+}
+
+func TestWriteJSONLinesError(t *testing.T) {
+	var buf bytes.Buffer
+	err := pie.WriteJSONLines(&buf, []float64{1.5, math.NaN(), 2})
+	assert.ErrorContains(t, err, "element 1: ")
+	assert.Equal(t, "1.5\n", buf.String())
+}
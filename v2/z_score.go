@@ -0,0 +1,43 @@
+package pie
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// ZScore returns the number of standard deviations each element is from the
+// mean, using the population standard deviation (like Stddev):
+//
+//	ZScore([2, 4, 4, 4, 5, 5, 7, 9]) => [-1.5, -0.5, -0.5, -0.5, 0, 0, 1, 2]
+//
+// The mean and standard deviation are calculated with float64, so they cannot
+// overflow for small integer types.
+//
+// If all of the elements are the same (so the standard deviation is zero),
+// every value is 0. nil is returned if there are no elements in the slice.
+func ZScore[T constraints.Integer | constraints.Float](ss []T) []float64 {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	average := mean(ss)
+
+	var variance float64
+	for _, s := range ss {
+		d := float64(s) - average
+		variance += d * d
+	}
+
+	stddev := math.Sqrt(variance / float64(len(ss)))
+	result := make([]float64, len(ss))
+	if stddev == 0 {
+		return result
+	}
+
+	for i, s := range ss {
+		result[i] = (float64(s) - average) / stddev
+	}
+
+	return result
+}
@@ -0,0 +1,32 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var zScoreTests = []struct {
+	ss       []float64
+	expected []float64
+}{
+	{nil, nil},
+	{[]float64{4}, []float64{0}},
+	{[]float64{4, 4}, []float64{0, 0}},
+	{[]float64{2, 4, 4, 4, 5, 5, 7, 9}, []float64{-1.5, -0.5, -0.5, -0.5, 0, 0, 1, 2}},
+	{[]float64{1, 3}, []float64{-1, 1}},
+}
+
+func TestZScore(t *testing.T) {
+	for _, test := range zScoreTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.ZScore(test.ss))
+		})
+	}
+}
+
+func TestZScoreSmallIntegers(t *testing.T) {
+	// The sum of the elements would overflow an int8.
+	assert.Equal(t, []float64{-1, 1}, pie.ZScore([]int8{100, 120}))
+}
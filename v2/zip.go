@@ -10,17 +10,14 @@ type Zipped[T1, T2 any] struct {
 // If input slices have diffrent length, the output slice will be truncated to
 // the length of the smallest input slice.
 func Zip[T1, T2 any](ss1 []T1, ss2 []T2) []Zipped[T1, T2] {
-	var minLen int
-
-	if len(ss1) <= len(ss2) {
-		minLen = len(ss1)
-	} else {
+	minLen := len(ss1)
+	if len(ss2) < minLen {
 		minLen = len(ss2)
 	}
 
-	ss3 := []Zipped[T1, T2]{}
-	for i := 0; i < minLen; i++ {
-		ss3 = append(ss3, Zipped[T1, T2]{ss1[i], ss2[i]})
+	ss3 := make([]Zipped[T1, T2], minLen)
+	for i := range ss3 {
+		ss3[i] = Zipped[T1, T2]{ss1[i], ss2[i]}
 	}
 
 	return ss3
@@ -0,0 +1,27 @@
+package pie
+
+// A struct containing three zipped values.
+type Zipped3[T1, T2, T3 any] struct {
+	A T1
+	B T2
+	C T3
+}
+
+// Zip3 works like Zip for three slices. The output slice will be truncated to
+// the length of the smallest input slice.
+func Zip3[T1, T2, T3 any](ss1 []T1, ss2 []T2, ss3 []T3) []Zipped3[T1, T2, T3] {
+	minLen := len(ss1)
+	if len(ss2) < minLen {
+		minLen = len(ss2)
+	}
+	if len(ss3) < minLen {
+		minLen = len(ss3)
+	}
+
+	ss4 := make([]Zipped3[T1, T2, T3], minLen)
+	for i := range ss4 {
+		ss4[i] = Zipped3[T1, T2, T3]{ss1[i], ss2[i], ss3[i]}
+	}
+
+	return ss4
+}
@@ -0,0 +1,32 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var zip3Tests = []struct {
+	ss1      []int
+	ss2      []string
+	ss3      []bool
+	expected []pie.Zipped3[int, string, bool]
+}{
+	{nil, nil, nil, []pie.Zipped3[int, string, bool]{}},
+	{[]int{1, 2}, []string{"a", "b"}, nil, []pie.Zipped3[int, string, bool]{}},
+	{
+		[]int{1, 2, 3},
+		[]string{"a", "b"},
+		[]bool{true, false, true},
+		[]pie.Zipped3[int, string, bool]{{1, "a", true}, {2, "b", false}},
+	},
+}
+
+func TestZip3(t *testing.T) {
+	for _, test := range zip3Tests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.Zip3(test.ss1, test.ss2, test.ss3))
+		})
+	}
+}
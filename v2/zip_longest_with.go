@@ -0,0 +1,26 @@
+package pie
+
+// ZipLongestWith works like ZipLongest, except that missing elements are
+// padded with fill1 (when ss1 is shorter) or fill2 (when ss2 is shorter)
+// instead of the zero value.
+func ZipLongestWith[T1, T2 any](ss1 []T1, ss2 []T2, fill1 T1, fill2 T2) []Zipped[T1, T2] {
+	maxLen := len(ss1)
+	if len(ss2) > maxLen {
+		maxLen = len(ss2)
+	}
+
+	ss3 := make([]Zipped[T1, T2], maxLen)
+	for i := range ss3 {
+		ss3[i] = Zipped[T1, T2]{fill1, fill2}
+
+		if i < len(ss1) {
+			ss3[i].A = ss1[i]
+		}
+
+		if i < len(ss2) {
+			ss3[i].B = ss2[i]
+		}
+	}
+
+	return ss3
+}
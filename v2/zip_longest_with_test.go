@@ -0,0 +1,27 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var zipLongestWithTests = []struct {
+	ss1      []int
+	ss2      []string
+	expected []pie.Zipped[int, string]
+}{
+	{nil, nil, []pie.Zipped[int, string]{}},
+	{[]int{1, 2}, []string{"a", "b"}, []pie.Zipped[int, string]{{1, "a"}, {2, "b"}}},
+	{[]int{1, 2, 3}, []string{"a"}, []pie.Zipped[int, string]{{1, "a"}, {2, "?"}, {3, "?"}}},
+	{[]int{1}, []string{"a", "b"}, []pie.Zipped[int, string]{{1, "a"}, {-1, "b"}}},
+}
+
+func TestZipLongestWith(t *testing.T) {
+	for _, test := range zipLongestWithTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.ZipLongestWith(test.ss1, test.ss2, -1, "?"))
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package pie
+
+// ZipN zips any number of slices of the same type. Each element of the result
+// contains one element from each of the slices, in the same order as sss:
+//
+//	ZipN([1, 2, 3], [4, 5, 6], [7, 8]) => [[1, 4, 7], [2, 5, 8]]
+//
+// The output slice will be truncated to the length of the smallest input
+// slice. If there are no slices, the result is nil.
+func ZipN[T any](sss ...[]T) [][]T {
+	if len(sss) == 0 {
+		return nil
+	}
+
+	minLen := len(sss[0])
+	for _, ss := range sss[1:] {
+		if len(ss) < minLen {
+			minLen = len(ss)
+		}
+	}
+
+	// All of the rows share a single allocation.
+	values := make([]T, minLen*len(sss))
+	zipped := make([][]T, minLen)
+	for i := range zipped {
+		row := values[i*len(sss) : (i+1)*len(sss) : (i+1)*len(sss)]
+		for j, ss := range sss {
+			row[j] = ss[i]
+		}
+
+		zipped[i] = row
+	}
+
+	return zipped
+}
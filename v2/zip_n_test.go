@@ -0,0 +1,33 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var zipNTests = []struct {
+	sss      [][]int
+	expected [][]int
+}{
+	{nil, nil},
+	{[][]int{{}}, [][]int{}},
+	{[][]int{{1, 2}}, [][]int{{1}, {2}}},
+	{[][]int{{1, 2, 3}, {4, 5, 6}, {7, 8}}, [][]int{{1, 4, 7}, {2, 5, 8}}},
+}
+
+func TestZipN(t *testing.T) {
+	for _, test := range zipNTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.ZipN(test.sss...))
+		})
+	}
+}
+
+func TestZipNRowsDoNotOverlap(t *testing.T) {
+	zipped := pie.ZipN([]int{1, 2}, []int{3, 4})
+	zipped[0] = append(zipped[0], 9)
+
+	assert.Equal(t, [][]int{{1, 3, 9}, {2, 4}}, zipped)
+}
@@ -0,0 +1,29 @@
+package pie
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrUnequalLengths is returned by functions that need slices of the same
+// length, such as ZipStrict.
+var ErrUnequalLengths = errors.New("slices have different lengths")
+
+// ZipStrict works like Zip, except that it returns ErrUnequalLengths (and a nil
+// slice) if ss1 and ss2 are not the same length, rather than truncating.
+func ZipStrict[T1, T2 any](ss1 []T1, ss2 []T2) ([]Zipped[T1, T2], error) {
+	if err := checkLengths(len(ss1), len(ss2)); err != nil {
+		return nil, err
+	}
+
+	return Zip(ss1, ss2), nil
+}
+
+// checkLengths returns ErrUnequalLengths if the lengths are different.
+func checkLengths(len1, len2 int) error {
+	if len1 != len2 {
+		return fmt.Errorf("%w: %d and %d", ErrUnequalLengths, len1, len2)
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package pie_test
+
+import (
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZipStrict(t *testing.T) {
+	zipped, err := pie.ZipStrict([]int{1, 2}, []string{"a", "b"})
+	assert.NoError(t, err)
+	assert.Equal(t, []pie.Zipped[int, string]{{1, "a"}, {2, "b"}}, zipped)
+
+	zipped, err = pie.ZipStrict([]int(nil), []string(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, []pie.Zipped[int, string]{}, zipped)
+
+	zipped, err = pie.ZipStrict([]int{1, 2, 3}, []string{"a", "b"})
+	assert.ErrorIs(t, err, pie.ErrUnequalLengths)
+	assert.EqualError(t, err, "slices have different lengths: 3 and 2")
+	assert.Nil(t, zipped)
+}
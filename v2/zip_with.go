@@ -0,0 +1,23 @@
+package pie
+
+// ZipWith returns a new slice containing the result of fn for each pair of
+// elements from ss1 and ss2. It is the same as mapping the result of Zip, but
+// without creating the pairs:
+//
+//	ZipWith([1, 2, 3], [10, 20], add) => [11, 22]
+//
+// If the input slices have different lengths, the output slice will be
+// truncated to the length of the smallest input slice.
+func ZipWith[T1, T2, U any](ss1 []T1, ss2 []T2, fn func(T1, T2) U) []U {
+	minLen := len(ss1)
+	if len(ss2) < minLen {
+		minLen = len(ss2)
+	}
+
+	ss3 := make([]U, minLen)
+	for i := range ss3 {
+		ss3[i] = fn(ss1[i], ss2[i])
+	}
+
+	return ss3
+}
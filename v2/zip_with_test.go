@@ -0,0 +1,30 @@
+package pie_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var zipWithTests = []struct {
+	ss1      []int
+	ss2      []string
+	expected []string
+}{
+	{nil, nil, []string{}},
+	{[]int{1, 2}, nil, []string{}},
+	{[]int{1, 2, 3}, []string{"a", "b"}, []string{"a1", "b2"}},
+	{[]int{1}, []string{"a", "b"}, []string{"a1"}},
+}
+
+func TestZipWith(t *testing.T) {
+	for _, test := range zipWithTests {
+		t.Run("", func(t *testing.T) {
+			assert.Equal(t, test.expected, pie.ZipWith(test.ss1, test.ss2, func(a int, b string) string {
+				return b + strconv.Itoa(a)
+			}))
+		})
+	}
+}
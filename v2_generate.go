@@ -0,0 +1,9 @@
+//go:build go1.18
+// +build go1.18
+
+package main
+
+// The v2 package uses type parameters, so template_v2.go can only be generated
+// with Go 1.18 or later. Older versions skip this file, and the directive.
+
+//go:generate go run generate_v2.go v2_template.go
@@ -0,0 +1,25 @@
+package main
+
+// This file is also compiled by "go run generate_v2.go v2_template.go", so it
+// must not depend on anything else in the package.
+
+// The levels of the constraints used by the v2 functions. Each level allows a
+// subset of the element types of the level before it.
+const (
+	v2Any = iota
+	v2Comparable
+	v2Ordered
+	v2Numeric
+)
+
+// v2LevelNames are the names of the level constants, in the same order.
+var v2LevelNames = []string{"v2Any", "v2Comparable", "v2Ordered", "v2Numeric"}
+
+// v2Template is a method for a named slice type that calls one of the v2
+// functions. They are generated into template_v2.go from the v2 chaining
+// wrappers.
+type v2Template struct {
+	Level   int
+	Imports []string
+	Code    string
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"go/format"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/elliotchance/testify-stats/assert"
+)
+
+var generateV2Tests = []struct {
+	sliceType string
+	level     int
+}{
+	{"Points", v2Comparable},
+	{"PointPointers", v2Comparable},
+	{"Grids", v2Comparable},
+	{"Paths", v2Any},
+	{"Indexes", v2Any},
+	{"Handlers", v2Any},
+	{"Boards", v2Any},
+	{"Labels", v2Any},
+	{"Names", v2Ordered},
+	{"Scores", v2Numeric},
+	{"TaggedPoints", v2Any},
+	{"FullNames", v2Ordered},
+	{"Temperatures", v2Numeric},
+}
+
+func TestGenerateV2(t *testing.T) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, "testdata/v2", nil, 0)
+	assert.NoError(t, err)
+
+	for _, test := range generateV2Tests {
+		t.Run(test.sliceType, func(t *testing.T) {
+			packageName, _, elementType, _ := findType(pkgs, test.sliceType)
+			level := getV2Level(fset, pkgs[packageName], test.sliceType)
+			assert.Equal(t, v2LevelNames[test.level], v2LevelNames[level])
+
+			source := generateV2(packageName, test.sliceType, elementType, []string{"*"}, level)
+			_, err := format.Source([]byte(source))
+			assert.NoError(t, err)
+
+			// Each method is only generated if the element type satisfies the
+			// constraint of the v2 function.
+			for name, method := range map[string]int{
+				"Filter":   v2Any,
+				"Contains": v2Comparable,
+				"Sort":     v2Ordered,
+				"Sum":      v2Numeric,
+			} {
+				signature := "func (ss " + test.sliceType + ") " + name + "("
+				assert.Equal(t, method <= test.level, strings.Contains(source, signature), name)
+			}
+		})
+	}
+}